@@ -191,11 +191,15 @@ func resolve(val reflect.Value) error {
 
 			path := field.String()
 			if len(path) == 0 {
+				if !required {
+					continue
+				}
 				return fmt.Errorf("field `%s` was tagged to be resolved from a file, but did not contain a path value", fieldT.Name)
 			}
 			if info, err := os.Stat(path); err != nil {
 				if os.IsNotExist(err) && !required {
-					return nil
+					field.SetString("")
+					continue
 				}
 				return err
 			} else {
@@ -0,0 +1,175 @@
+package core
+
+/*
+ * File: pkg/core/access.go
+ *
+ * Purpose: event staff roles and the permissions they grant
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Type `eventPermission` represents an action on an event that requires a staff role
+type eventPermission string
+
+// Constants storing the actions on an event that require a staff role
+const (
+	permViewEvent          eventPermission = "view event"
+	permManageEvent        eventPermission = "manage event"
+	permDeleteEvent        eventPermission = "delete event"
+	permManageStaff        eventPermission = "manage staff"
+	permManageParticipants eventPermission = "manage participants"
+	permManageMatches      eventPermission = "manage matches"
+	permScoreMatches       eventPermission = "score matches"
+)
+
+// Mapping of event staff roles to the permissions they grant
+var eventRolePermissions = map[string][]eventPermission{
+	models.RoleOwner: {
+		permViewEvent, permManageEvent, permDeleteEvent, permManageStaff, permManageParticipants, permManageMatches, permScoreMatches,
+	},
+	models.RoleOrganizer: {
+		permViewEvent, permManageEvent, permManageParticipants, permManageMatches, permScoreMatches,
+	},
+	models.RoleScorekeeper: {
+		permViewEvent, permScoreMatches,
+	},
+	models.RoleViewer: {
+		permViewEvent,
+	},
+}
+
+// Type `permissionError` represents an action rejected because the caller's role on the event does not grant it
+//
+// Members:
+//   - role: the role of the caller on the event (empty if the caller is not on the staff)
+//   - needs: the permission the action requires
+type permissionError struct {
+	role  string
+	needs eventPermission
+}
+
+// Function `permissionError.Error` implements the error interface for the permissionError type
+func (e permissionError) Error() string {
+	if e.role == "" {
+		return fmt.Sprintf("not on the event staff (requires %s)", e.needs)
+	}
+	return fmt.Sprintf("role %s does not allow %s", e.role, e.needs)
+}
+
+// Function `isPermissionError` determines if the given error is an action rejected by the caller's event role
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isPermissionError(e error) (error, bool) {
+	var rejected permissionError
+	if errors.As(e, &rejected) {
+		return handlerutil.ErrNoAccess(
+			handlerutil.NewDetail("permission", rejected.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `eventRoleOf` determines the role of a user on an event
+//
+// Parameters:
+//   - event: the event to check
+//   - user: the user to find the role of
+//
+// Returns:
+//   - `string`: the role of the user (empty if the user is not on the event staff)
+func eventRoleOf(event models.EventRecord, user bson.ObjectID) string {
+	if user == event.Host {
+		return models.RoleOwner
+	}
+
+	for _, member := range event.Staff {
+		if member.User == user {
+			return member.Role
+		}
+	}
+
+	return ""
+}
+
+// Function `roleGrants` determines if an event role grants a permission
+//
+// Parameters:
+//   - role: the event role
+//   - p: the permission to check
+//
+// Returns:
+//   - `bool`: whether the role grants the permission
+func roleGrants(role string, p eventPermission) bool {
+	return slices.Contains(eventRolePermissions[role], p)
+}
+
+// Function `requireEventPermission` creates a processing step that checks the user presented in the access token holds a role granting the given permission
+// on the event record within the workspace
+//
+// Parameters:
+//   - p: the permission the handler requires
+//
+// Returns:
+//   - `func(context.Context, *handlerutil.HandlerWorkspace) error`: the processing step performing the check
+func requireEventPermission(p eventPermission) func(context.Context, *handlerutil.HandlerWorkspace) error {
+	return func(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+		var whoami string
+		var userid bson.ObjectID
+		var record models.EventRecord
+		var err error
+
+		log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+		if err = space.Get(activeUserID, &whoami); err != nil {
+			log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+			return err
+		}
+
+		log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+		if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+			log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+			return err
+		}
+
+		log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", eventRecordKey, record)
+		if err = space.Get(eventRecordKey, &record); err != nil {
+			log.Printf("[HANDLER]: error loading event record data (%s)", err.Error())
+			return err
+		}
+
+		role := eventRoleOf(record, userid)
+		log.Printf("[HANDLER]: checking role %q of token user on event grants %q...", role, p)
+		if !roleGrants(role, p) {
+			log.Print("[HANDLER]: permission not granted, rejecting request")
+			return permissionError{role: role, needs: p}
+		}
+
+		log.Printf("[HANDLER]: checking API key (if any) is scoped for %q on the event...", p)
+		if err = apiKeyAllows(space, record, p); err != nil {
+			log.Printf("[HANDLER]: API key not scoped for the request (%s)", err.Error())
+			return err
+		}
+
+		log.Print("[HANDLER]: permission verified, proceeding with request")
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+package core
+
+/*
+ * File: pkg/core/access_test.go
+ *
+ * Purpose: unit tests for the event staff permission logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestRequireEventPermission(t *testing.T) {
+	host, organizer, scorekeeper, viewer := bson.NewObjectID(), bson.NewObjectID(), bson.NewObjectID(), bson.NewObjectID()
+	event := models.EventRecord{
+		ID:   bson.NewObjectID(),
+		Host: host,
+		Staff: []models.EventStaffMember{
+			{User: organizer, Role: models.RoleOrganizer},
+			{User: scorekeeper, Role: models.RoleScorekeeper},
+			{User: viewer, Role: models.RoleViewer},
+		},
+	}
+
+	check := func(user bson.ObjectID, p eventPermission) error {
+		space := handlerutil.DefaultWorkspace()
+		space.Set(activeUserID, user.Hex())
+		space.Set(eventRecordKey, event)
+		return requireEventPermission(p)(context.Background(), &space)
+	}
+
+	t.Run("RolesResolved", func(t *testing.T) {
+		assert.Equal(t, models.RoleOwner, eventRoleOf(event, host))
+		assert.Equal(t, models.RoleScorekeeper, eventRoleOf(event, scorekeeper))
+		assert.Empty(t, eventRoleOf(event, bson.NewObjectID()))
+	})
+
+	t.Run("OwnerAllowedEverything", func(t *testing.T) {
+		for _, p := range eventRolePermissions[models.RoleOwner] {
+			assert.NoError(t, check(host, p), p)
+		}
+	})
+
+	t.Run("ScorekeeperDeclaresButCannotDelete", func(t *testing.T) {
+		assert.NoError(t, check(scorekeeper, permScoreMatches))
+
+		err := check(scorekeeper, permDeleteEvent)
+		require.Error(t, err)
+		_, rejected := isPermissionError(err)
+		assert.True(t, rejected)
+	})
+
+	t.Run("OrganizerCannotManageStaff", func(t *testing.T) {
+		assert.NoError(t, check(organizer, permManageMatches))
+		assert.Error(t, check(organizer, permManageStaff))
+		assert.Error(t, check(organizer, permDeleteEvent))
+	})
+
+	t.Run("ViewerReadOnly", func(t *testing.T) {
+		assert.NoError(t, check(viewer, permViewEvent))
+		assert.Error(t, check(viewer, permScoreMatches))
+	})
+
+	t.Run("StrangerRejected", func(t *testing.T) {
+		err := check(bson.NewObjectID(), permViewEvent)
+		_, rejected := isPermissionError(err)
+		assert.True(t, rejected)
+	})
+}
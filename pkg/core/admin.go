@@ -0,0 +1,742 @@
+package core
+
+/*
+ * File: pkg/core/admin.go
+ *
+ * Purpose: platform administration and moderation logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Workspace keys associated with platform administration workspace tasks
+const (
+	adminUserLookupRequest = "adminLookupUserRequest"
+	adminUserListRequest   = "adminListUsersRequest"
+	moderatedAccountKey    = "moderatedAccountRecord"
+	closedSessionCountKey  = "closedSessionCount"
+	moderationResponseKey  = "accountModerationResponse"
+	userListResponseKey    = "userListResponse"
+)
+
+// Errors specific to platform administration workflow tasks
+var (
+	ErrSelfDeactivation = errors.New("administrators cannot deactivate their own account")
+)
+
+// Function `(*tournabyteAPIService).initAdminWorkspace` initializes the handler workspace for a platform administration request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for an administration action
+func (srv *tournabyteAPIService) initAdminWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders|handlerutil.ShouldHaveQueryParameters)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
+// Function `isAdminRoleRequiredError` determines if the given error is an administration action attempted without the administrator role
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isAdminRoleRequiredError(e error) (error, bool) {
+	if errors.Is(e, ErrAdminRoleRequired) {
+		return handlerutil.ErrNoAccess(
+			handlerutil.NewDetail("role", e.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `isSelfDeactivationError` determines if the given error is an administrator attempting to deactivate their own account
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isSelfDeactivationError(e error) (error, bool) {
+	if errors.Is(e, ErrSelfDeactivation) {
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("account", e.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `decodeUserAccount` decodes an account document, treating documents without an active flag as active
+//
+// This mirrors the `metadata.active: {$ne: false}` condition used to filter active accounts, so that accounts created before document metadata was
+// recorded are reported the same way they are looked up.
+//
+// Parameters:
+//   - raw: the account document
+//
+// Returns:
+//   - `models.UserAccount`: the decoded account
+//   - `error`: issue decoding the document
+func decodeUserAccount(raw bson.Raw) (models.UserAccount, error) {
+	var acct models.UserAccount
+	if err := bson.Unmarshal(raw, &acct); err != nil {
+		return acct, err
+	}
+	if flag, err := raw.LookupErr("metadata", "active"); err != nil {
+		acct.Metadata.Active = true
+	} else if active, ok := flag.BooleanOK(); !ok || active {
+		acct.Metadata.Active = true
+	}
+	return acct, nil
+}
+
+// Function `listUsersPipeline` initializes a handling pipeline for listing user accounts
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func listUsersPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAdminRole, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindUserListRequestFromQuery, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchUserAccountPageFromDatabase, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `deactivateUserPipeline` initializes a handling pipeline for deactivating a user account and closing its sessions
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func deactivateUserPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAdminRole, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindUserLookupRequestFromURI, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchModeratedAccountRecord, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyAccountActiveStatus(false), out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, closeModeratedAccountSessions, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(moderatedAccountTokenScope), out7)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateAccountModerationResponse, out8)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `reactivateUserPipeline` initializes a handling pipeline for reactivating a deactivated user account
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func reactivateUserPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAdminRole, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindUserLookupRequestFromURI, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchModeratedAccountRecord, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyAccountActiveStatus(true), out5)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateAccountModerationResponse, out6)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `closeUserSessionsPipeline` initializes a handling pipeline for force-closing every session of a user account
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func closeUserSessionsPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAdminRole, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindUserLookupRequestFromURI, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchModeratedAccountRecord, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, closeModeratedAccountSessions, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(moderatedAccountTokenScope), out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateAccountModerationResponse, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `takeOverEventPipeline` initializes a handling pipeline for an administrator becoming the host of an event
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func takeOverEventPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAdminRole, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, transferEventHostToActiveUser, out5)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out6)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `adminEventDeletionPipeline` initializes a handling pipeline for an administrator removing an event regardless of its staff
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func adminEventDeletionPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAdminRole, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventRemovalRequestFromQuery, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeEventDependentRecords, out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, removeEventRecordByID, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `requireAdminRole` checks that the access token presented was issued to a platform administrator
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func requireAdminRole(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var role string
+
+	log.Printf("[HANDLER]: loading user role within access token under %q into variable of type %T...", activeUserRole, role)
+	if err := space.Get(activeUserRole, &role); err != nil {
+		log.Printf("[HANDLER]: error loading user role (%s)", err.Error())
+		return err
+	}
+
+	if role != models.AccountRoleAdmin {
+		log.Printf("[HANDLER]: token user role %q is not an administrator, rejecting request", role)
+		return ErrAdminRoleRequired
+	}
+
+	log.Print("[HANDLER]: administrator role verified, proceeding with request")
+	return nil
+}
+
+// Function `bindUserLookupRequestFromURI` binds the request URI to the user lookup request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindUserLookupRequestFromURI(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.UserID
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request URI to variable of type %T...", lookup)
+	if err := bindings.BindURI(&lookup); err != nil {
+		log.Printf("[HANDLER]: error binding request URI (%s)", err.Error())
+		return err
+	}
+
+	space.Set(adminUserLookupRequest, lookup)
+	log.Printf("[HANDLER]: saved request URI as variable of type %T within workspace under key %q", lookup, adminUserLookupRequest)
+	return nil
+}
+
+// Function `bindUserListRequestFromQuery` binds the request query parameters to the user listing request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindUserListRequestFromQuery(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var query models.ListUsersRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request query parameters to variable of type %T...", query)
+	if err := bindings.BindQueryParameters(&query); err != nil {
+		log.Printf("[HANDLER]: error binding request query parameters (%s)", err.Error())
+		return err
+	}
+
+	if query.Limit == 0 {
+		query.Limit = models.DefaultListLimit
+	}
+
+	space.Set(adminUserListRequest, query)
+	log.Printf("[HANDLER]: saved request query parameters as variable of type %T within workspace under key %q", query, adminUserListRequest)
+	return nil
+}
+
+// Function `fetchUserAccountPageFromDatabase` fetches the page of user accounts matching the listing request within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchUserAccountPageFromDatabase(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var sess *mongo.Session
+	var cur *mongo.Cursor
+	var req models.ListUsersRequest
+	var accounts []bson.Raw
+	var page models.UserListResponse
+	var after *pageCursor
+	var cfg *options.FindOptionsBuilder
+	var err error
+
+	log.Printf("[HANDLER]: loading user listing request from workspace under %q key into variable of type %T...", adminUserListRequest, req)
+	if err := space.Get(adminUserListRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading listing request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting page cursor presented in listing request...")
+	if after, err = decodePageCursor(req.Cursor); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided page cursor (%s)", err.Error())
+		return err
+	}
+
+	filter := bson.D{}
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "login_email", Value: bson.Regex{Pattern: regexp.QuoteMeta(req.Search), Options: "i"}})
+	}
+	switch req.Status {
+	case "active":
+		filter = append(filter, bson.E{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}})
+	case "inactive":
+		filter = append(filter, bson.E{Key: "metadata.active", Value: false})
+	}
+	if after != nil {
+		log.Printf("[HANDLER]: resuming listing after account (_id=%q)...", after.ID.Hex())
+		resume, err := keysetCondition([]bson.E{{Key: "_id", Value: 1}}, *after)
+		if err != nil {
+			log.Printf("[HANDLER]: page cursor does not match the listing ordering (%s)", err.Error())
+			return err
+		}
+		filter = append(filter, resume...)
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(
+		dbx.FindCap(req.Limit+1),
+		dbx.FindSortKey(bson.E{Key: "_id", Value: 1}),
+		dbx.FindProjection(bson.E{Key: "password_hash", Value: 0}),
+	); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	cur, err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		Find(ctx, filter, cfg)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	if err = cur.All(ctx, &accounts); err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	var last bson.ObjectID
+	more := int64(len(accounts)) > req.Limit
+	if more {
+		accounts = accounts[:req.Limit]
+	}
+
+	page.Users = make([]models.UserAccountSummary, 0, len(accounts))
+	for _, raw := range accounts {
+		acct, err := decodeUserAccount(raw)
+		if err != nil {
+			log.Printf("[HANDLER]: error decoding account document (%s)", err.Error())
+			return err
+		}
+		last = acct.ID
+		page.Users = append(page.Users, models.UserAccountSummary{
+			ID:        acct.ID.Hex(),
+			Email:     acct.LoginEmail,
+			Role:      accountRole(acct),
+			Active:    acct.Metadata.Active,
+			CreatedAt: acct.Metadata.CreatedAt,
+		})
+	}
+	if more {
+		page.Next = encodePageCursor(pageCursor{ID: last})
+	}
+
+	log.Printf("[HANDLER]: found %d accounts (more=%t)", len(page.Users), page.Next != "")
+	space.Set(userListResponseKey, page)
+	return nil
+}
+
+// Function `fetchModeratedAccountRecord` retrieves the account record referenced by the user lookup request within the workspace (including deactivated accounts)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchModeratedAccountRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.UserID
+	var userid bson.ObjectID
+	var raw bson.Raw
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user lookup request from workspace under %q key into variable of type %T...", adminUserLookupRequest, lookup)
+	if err = space.Get(adminUserLookupRequest, &lookup); err != nil {
+		log.Printf("[HANDLER]: error loading lookup request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(lookup.ID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	raw, err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, bson.D{{Key: "_id", Value: userid}}).
+		Raw()
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	if acct, err = decodeUserAccount(raw); err != nil {
+		log.Printf("[HANDLER]: error decoding account document (%s)", err.Error())
+		return err
+	}
+
+	space.Set(moderatedAccountKey, acct)
+	return nil
+}
+
+// Function `applyAccountActiveStatus` creates a processing step that activates or deactivates the moderated account within the workspace
+//
+// Administrators cannot deactivate their own account.
+//
+// Parameters:
+//   - active: whether the account should be able to sign in
+//
+// Returns:
+//   - `func(context.Context, *handlerutil.HandlerWorkspace) error`: the processing step applying the status
+func applyAccountActiveStatus(active bool) func(context.Context, *handlerutil.HandlerWorkspace) error {
+	return func(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+		var acct models.UserAccount
+		var whoami string
+		var sess *mongo.Session
+		var res *mongo.UpdateResult
+		var now = time.Now().UTC()
+		var err error
+
+		log.Printf("[HANDLER]: loading moderated account from workspace under %q key into variable of type %T...", moderatedAccountKey, acct)
+		if err = space.Get(moderatedAccountKey, &acct); err != nil {
+			log.Printf("[HANDLER]: error loading moderated account (%s)", err.Error())
+			return err
+		}
+
+		log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+		if err = space.Get(activeUserID, &whoami); err != nil {
+			log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+			return err
+		}
+
+		if !active && acct.ID.Hex() == whoami {
+			log.Printf("[HANDLER]: administrators cannot deactivate their own account")
+			return ErrSelfDeactivation
+		}
+
+		log.Printf("[HANDLER]: loading database session from request context...")
+		if sess, err = dbx.MongoFromContext(ctx); err != nil {
+			log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+			return err
+		}
+
+		log.Print("[HANDLER]: running database update operation...")
+		res, err = sess.Client().
+			Database(models.UserAccountQueryContext.Database).
+			Collection(models.UserAccountQueryContext.Collection).
+			UpdateByID(
+				ctx,
+				acct.ID,
+				bson.D{{Key: "$set", Value: bson.D{{Key: "metadata.active", Value: active}, {Key: "metadata.updated_at", Value: now}}}},
+			)
+
+		if err != nil {
+			log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+			return err
+		}
+
+		if res.MatchedCount != 1 {
+			log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+			return errors.New("update not properly applied")
+		}
+
+		log.Printf("[HANDLER]: account (_id=%q) active status set to %t", acct.ID.Hex(), active)
+		acct.Metadata.Active = active
+		acct.Metadata.UpdatedAt = now
+		space.Set(moderatedAccountKey, acct)
+		return nil
+	}
+}
+
+// Function `closeModeratedAccountSessions` removes every session record authorizing the moderated account within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func closeModeratedAccountSessions(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var res *mongo.DeleteResult
+	var err error
+
+	log.Printf("[HANDLER]: loading moderated account from workspace under %q key into variable of type %T...", moderatedAccountKey, acct)
+	if err = space.Get(moderatedAccountKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading moderated account (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation (authorizes=%q)", acct.ID.Hex())
+	res, err = sess.Client().
+		Database(models.UserSessionQueryContext.Database).
+		Collection(models.UserSessionQueryContext.Collection).
+		DeleteMany(ctx, bson.D{{Key: "authorizes", Value: acct.ID}})
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database deletion (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: closed %d sessions of account (_id=%q)", res.DeletedCount, acct.ID.Hex())
+	space.Set(closedSessionCountKey, res.DeletedCount)
+	return nil
+}
+
+// Function `populateAccountModerationResponse` populates the response fields for a moderation action on a user account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func populateAccountModerationResponse(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var closed int64
+
+	log.Printf("[HANDLER]: loading moderated account from workspace under %q key into variable of type %T...", moderatedAccountKey, acct)
+	if err := space.Get(moderatedAccountKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading moderated account (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading closed session count from workspace under %q key...", closedSessionCountKey)
+	if err := space.Get(closedSessionCountKey, &closed); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading closed session count (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: saved response data to workspace under the %q key", moderationResponseKey)
+	space.Set(moderationResponseKey, models.AccountModerationResponse{
+		ID:             acct.ID.Hex(),
+		Active:         acct.Metadata.Active,
+		SessionsClosed: closed,
+	})
+	return nil
+}
+
+// Function `transferEventHostToActiveUser` makes the user presented in the access token the host of the event record within the workspace
+//
+// The previous host loses all access to the event; the new host is removed from the event staff if they were on it.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func transferEventHostToActiveUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var whoami string
+	var userid bson.ObjectID
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateByID(
+			ctx,
+			event.ID,
+			bson.D{
+				{Key: "$set", Value: bson.D{{Key: "host", Value: userid}}},
+				{Key: "$pull", Value: bson.D{{Key: "staff", Value: bson.D{{Key: "user", Value: userid}}}}},
+			},
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return errors.New("update not properly applied")
+	}
+
+	log.Printf("[HANDLER]: event (_id=%q) host moved from %q to %q", event.ID.Hex(), event.Host.Hex(), whoami)
+	event.Host = userid
+	space.Set(eventRecordKey, event)
+	return nil
+}
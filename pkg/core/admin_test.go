@@ -0,0 +1,242 @@
+package core
+
+/*
+ * File: pkg/core/admin_test.go
+ *
+ * Purpose: unit tests for the platform administration pipelines
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+)
+
+var findLegacyUserOk = bson.D{
+	{Key: "ok", Value: 1},
+	{Key: "cursor", Value: bson.D{
+		{Key: "id", Value: int64(0)},
+		{Key: "ns", Value: "tournabyte.users"},
+		{Key: "firstBatch", Value: bson.A{
+			bson.M{
+				"_id":         bson.NewObjectID(),
+				"login_email": "legacyuser@example.io",
+			},
+		}},
+	}},
+}
+
+func setupWorkingAdminContext(t *testing.T, responses ...bson.D) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		append([]bson.D{pingResponse}, responses...)...,
+	)
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func setupWorkingAdminWorkspace(t *testing.T, role string, uri any, query any) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := handlerutil.DefaultWorkspace()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(`1010101010101010101010101010101010101010101010101010101010101010`)}, nil)
+	require.NoError(t, err)
+	tokenOpts := models.TokenOptions{
+		Subject:   "testsubject",
+		Issuer:    "testissuer",
+		Signer:    signer,
+		ExpiresIn: 5 * time.Minute,
+		Key:       `1010101010101010101010101010101010101010101010101010101010101010`,
+		Algorithm: "HS256",
+	}
+	cl1 := jwt.Claims{
+		Subject:   tokenOpts.Subject,
+		Issuer:    tokenOpts.Issuer,
+		IssuedAt:  jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		NotBefore: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		Expiry:    jwt.NewNumericDate(time.Now().Add(tokenOpts.ExpiresIn)),
+	}
+	cl2 := models.AuthorizationTokenClaims{
+		Me:   bson.NewObjectID().Hex(),
+		Role: role,
+	}
+	token, err := jwt.Signed(signer).Claims(cl1).Claims(cl2).Serialize()
+	require.NoError(t, err)
+
+	header := models.AuthorizationHeaderContent{
+		Token: token,
+	}
+
+	bindTo := func(val any) func(any) error {
+		return func(a any) error {
+			outVal := reflect.ValueOf(a)
+			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+				return handlerutil.ErrNotAddressable
+			}
+
+			valVal := reflect.ValueOf(val)
+			if !valVal.IsValid() || !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+				return handlerutil.ErrNotAssignable
+			}
+			outVal.Elem().Set(valVal)
+			return nil
+		}
+	}
+
+	space.Set(handlerutil.RequestBindings, handlerutil.Bindings{
+		URI:     bindTo(uri),
+		Headers: bindTo(header),
+		Query:   bindTo(query),
+	})
+
+	space.Set(authTokenOptionsKey, tokenOpts)
+	space.Set(models.ValidatorObjectKey, validator.New())
+
+	return &space
+}
+
+func TestAdminPipeline(t *testing.T) {
+	t.Run("NonAdminRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := listUsersPipeline(setupWorkingAdminContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, models.ListUsersRequest{})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		_, rejected := isAdminRoleRequiredError(context.Cause(pCtx))
+		assert.True(t, rejected)
+	})
+
+	t.Run("ListUsersOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := listUsersPipeline(setupWorkingAdminContext(t, findUserOk))
+		var result models.UserListResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleAdmin, nil, models.ListUsersRequest{Search: "testuser"})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userListResponseKey, &result))
+
+		require.Len(t, result.Users, 1)
+		assert.Equal(t, findUserDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex(), result.Users[0].ID)
+		assert.Equal(t, models.AccountRoleUser, result.Users[0].Role)
+		assert.Empty(t, result.Next)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("ListUsersWithoutMetadataActive", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := listUsersPipeline(setupWorkingAdminContext(t, findLegacyUserOk))
+		var result models.UserListResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleAdmin, nil, models.ListUsersRequest{})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userListResponseKey, &result))
+
+		require.Len(t, result.Users, 1)
+		assert.True(t, result.Users[0].Active)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("DeactivateSelfRefused", func(t *testing.T) {
+		target := findUserDoc[0].(bson.M)["_id"].(bson.ObjectID)
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleAdmin, models.UserID{ID: target.Hex()}, nil)
+		space.Set(moderatedAccountKey, models.UserAccount{ID: target})
+		space.Set(activeUserID, target.Hex())
+
+		err := applyAccountActiveStatus(false)(setupWorkingAdminContext(t), space)
+		require.ErrorIs(t, err, ErrSelfDeactivation)
+
+		_, classified := isSelfDeactivationError(err)
+		assert.True(t, classified)
+	})
+
+	t.Run("DeactivateUserClosesSessions", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := deactivateUserPipeline(setupWorkingAdminContext(t, findUserOk, updateOneOk, deleteManyOk))
+		var result models.AccountModerationResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		target := findUserDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex()
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleAdmin, models.UserID{ID: target}, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(moderationResponseKey, &result))
+
+		assert.Equal(t, target, result.ID)
+		assert.False(t, result.Active)
+		assert.Equal(t, int64(3), result.SessionsClosed)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("TakeOverEventOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := takeOverEventPipeline(setupWorkingAdminContext(t, findEventOk, updateOneOk))
+		var result models.EventID
+		defer close(pIn)
+		defer pCancel(nil)
+
+		event := findEventDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex()
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleAdmin, models.EventID{ID: event}, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventIDResponseKey, &result))
+
+		assert.Equal(t, event, result.ID)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+}
@@ -0,0 +1,624 @@
+package core
+
+/*
+ * File: pkg/core/apikeys.go
+ *
+ * Purpose: long-lived scoped API keys for machine clients
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Prefix identifying API keys presented in place of an access token
+const apiKeyPrefix = "tbk_"
+
+// Number of leading characters of an API key kept to tell keys apart
+const apiKeyHintLength = len(apiKeyPrefix) + 6
+
+// Workspace keys associated with API key workspace tasks
+const (
+	apiKeyRequestKey  = "newAPIKeyRequest"
+	apiKeyLookupKey   = "apiKeyLookup"
+	apiKeyResponseKey = "apiKeyResponse"
+	activeAPIKey      = "activeAPIKey"
+)
+
+// Errors specific to API key workflow tasks
+var (
+	ErrInvalidAPIKey     = errors.New("API key is invalid, expired or revoked")
+	ErrAPIKeyNotAccepted = errors.New("this operation requires logging in (API keys are not accepted)")
+)
+
+// Mapping of API key scopes to the event permissions they allow (the role of the key owner must grant them as well)
+var apiKeyScopePermissions = map[string][]eventPermission{
+	models.APIKeyScopeRead: {
+		permViewEvent,
+	},
+	models.APIKeyScopeScore: {
+		permViewEvent, permScoreMatches,
+	},
+	models.APIKeyScopeManage: {
+		permViewEvent, permManageEvent, permDeleteEvent, permManageStaff, permManageParticipants, permManageMatches, permScoreMatches,
+	},
+}
+
+// Type `apiKeyScopeError` represents an action rejected because the API key presented is not scoped for it
+//
+// Members:
+//   - needs: the permission the action requires (empty when the event is outside the key's event restriction)
+//   - scope: the scope the action requires (set for actions not tied to an event permission)
+type apiKeyScopeError struct {
+	needs eventPermission
+	scope string
+}
+
+// Function `apiKeyScopeError.Error` implements the error interface for the apiKeyScopeError type
+func (e apiKeyScopeError) Error() string {
+	switch {
+	case e.scope != "":
+		return fmt.Sprintf("API key is not scoped for this operation (requires %s on every event)", e.scope)
+	case e.needs == "":
+		return "API key is restricted to other events"
+	default:
+		return fmt.Sprintf("API key is not scoped for this operation (requires %s)", e.needs)
+	}
+}
+
+// Function `(*tournabyteAPIService).initAPIKeyWorkspace` initializes the handler workspace for an API key management request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for managing the API keys of the current user
+func (srv *tournabyteAPIService) initAPIKeyWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
+// Function `apiKeyCreationPipeline` initializes a handling pipeline for issuing an API key to the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func apiKeyCreationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireVerifiedEmail, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindNewAPIKeyRequest, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createAPIKeyRecord, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `listAPIKeysPipeline` initializes a handling pipeline for listing the API keys of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func listAPIKeysPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAPIKeysOfUser, out2)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `revokeAPIKeyPipeline` initializes a handling pipeline for revoking an API key of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func revokeAPIKeyPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAPIKeyIDFromURI, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out2)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, deleteAPIKeyRecord, out3)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindNewAPIKeyRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the API key process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindNewAPIKeyRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.NewAPIKeyRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(apiKeyRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, apiKeyRequestKey)
+	return nil
+}
+
+// Function `bindAPIKeyIDFromURI` binds the request URI and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindAPIKeyIDFromURI(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.APIKeyLookupRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request URI to variable of type %T...", lookup)
+	if err := bindings.BindURI(&lookup); err != nil {
+		log.Printf("[HANDLER]: error binding request URI (%s)", err.Error())
+		return err
+	}
+
+	space.Set(apiKeyLookupKey, lookup)
+	log.Printf("[HANDLER]: saved request URI as variable of type %T within workspace under key %q", lookup, apiKeyLookupKey)
+	return nil
+}
+
+// Function `createAPIKeyRecord` generates an API key for the user presented in the access token and stores its hash
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createAPIKeyRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var req models.NewAPIKeyRequest
+	var sess *mongo.Session
+	var cfg *options.InsertOneOptionsBuilder
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading API key request from workspace under key %q", apiKeyRequestKey)
+	if err = space.Get(apiKeyRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading API key request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating API key...")
+	raw := apiKeyPrefix + rand.Text()
+	record := models.APIKeyRecord{
+		ID:        bson.NewObjectID(),
+		Hash:      fmt.Sprintf("%x", sha256.Sum256([]byte(raw))),
+		Hint:      raw[:apiKeyHintLength],
+		Owner:     userid,
+		Name:      req.Name,
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		CreatedAt: now,
+	}
+	for _, hex := range req.Events {
+		event, err := bson.ObjectIDFromHex(hex)
+		if err != nil {
+			log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+			return err
+		}
+		record.Events = append(record.Events, event)
+	}
+	if req.ExpiresIn > 0 {
+		expires := now.AddDate(0, 0, req.ExpiresIn)
+		record.ExpiresAt = &expires
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation")
+	if _, err = sess.Client().
+		Database(models.APIKeyQueryContext.Database).
+		Collection(models.APIKeyQueryContext.Collection).
+		InsertOne(ctx, record, cfg); err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(apiKeyResponseKey, models.IssuedAPIKey{APIKeyRecord: record, Key: raw})
+	log.Printf("[HANDLER]: API key (_id=%q) issued to user %q", record.ID.Hex(), whoami)
+	return nil
+}
+
+// Function `fetchAPIKeysOfUser` retrieves the API key records of the user presented in the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchAPIKeysOfUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var sess *mongo.Session
+	var cur *mongo.Cursor
+	var records = make([]models.APIKeyRecord, 0)
+	var cfg *options.FindOptionsBuilder
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.FindSortKey(bson.E{Key: "created_at", Value: -1})); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	cur, err = sess.Client().
+		Database(models.APIKeyQueryContext.Database).
+		Collection(models.APIKeyQueryContext.Collection).
+		Find(ctx, bson.D{{Key: "owner", Value: userid}}, cfg)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	if err = cur.All(ctx, &records); err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: found %d API keys for user %q", len(records), whoami)
+	space.Set(apiKeyResponseKey, records)
+	return nil
+}
+
+// Function `deleteAPIKeyRecord` removes the API key referenced by the request URI from the keys of the user presented in the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func deleteAPIKeyRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var keyid bson.ObjectID
+	var lookup models.APIKeyLookupRequest
+	var sess *mongo.Session
+	var res *mongo.DeleteResult
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading API key lookup from workspace under %q key...", apiKeyLookupKey)
+	if err = space.Get(apiKeyLookupKey, &lookup); err != nil {
+		log.Printf("[HANDLER]: error loading API key lookup (%s)", err.Error())
+		return err
+	}
+
+	if keyid, err = bson.ObjectIDFromHex(lookup.ID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation (_id=%q, owner=%q)", lookup.ID, whoami)
+	res, err = sess.Client().
+		Database(models.APIKeyQueryContext.Database).
+		Collection(models.APIKeyQueryContext.Collection).
+		DeleteOne(ctx, bson.D{{Key: "_id", Value: keyid}, {Key: "owner", Value: userid}})
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database deletion (%s)", err.Error())
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		log.Printf("[HANDLER]: no API key (_id=%q) found for user %q", lookup.ID, whoami)
+		return mongo.ErrNoDocuments
+	}
+
+	space.Set(apiKeyResponseKey, gin.H{"keyRevoked": time.Now().UTC()})
+	log.Printf("[HANDLER]: API key (_id=%q) of user %q revoked", lookup.ID, whoami)
+	return nil
+}
+
+// Function `validateAPIKey` looks up the API key presented in place of an access token and notes its owner in the workspace
+//
+// Keys act with the user role of their owner only (never the administrator role) and stop working once the owner is deactivated.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//   - raw: the API key presented
+//
+// Returns:
+//   - `error`: `ErrInvalidAPIKey` if no unexpired key of an active account matches (or another error that occurred during this processing step)
+func validateAPIKey(ctx context.Context, space *handlerutil.HandlerWorkspace, raw string) error {
+	var record models.APIKeyRecord
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: looking up API key...")
+	filter := bson.D{
+		{Key: "hash", Value: fmt.Sprintf("%x", sha256.Sum256([]byte(raw)))},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "expires_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "expires_at", Value: bson.D{{Key: "$gt", Value: now}}}},
+		}},
+	}
+	err = sess.Client().
+		Database(models.APIKeyQueryContext.Database).
+		Collection(models.APIKeyQueryContext.Collection).
+		FindOne(ctx, filter).
+		Decode(&record)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no unexpired API key matches the one presented")
+		return ErrInvalidAPIKey
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: looking up owner (_id=%q) of API key...", record.Owner.Hex())
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, bson.D{{Key: "_id", Value: record.Owner}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}).
+		Decode(&acct)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: owner of API key is no longer active")
+		return ErrInvalidAPIKey
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("API key (_id=%q) successfully validated and key owner noted in workspace under %q", record.ID.Hex(), activeUserID)
+	space.Set(activeAPIKey, record)
+	space.Set(activeUserID, record.Owner.Hex())
+	space.Set(activeUserRole, models.AccountRoleUser)
+	space.Set(activeUserVerified, acct.EmailVerified)
+	return nil
+}
+
+// Function `apiKeyAllows` determines whether the API key presented (if any) is scoped for a permission on an event
+//
+// Parameters:
+//   - space: the workspace to utilize
+//   - event: the event the permission is needed on
+//   - p: the permission needed
+//
+// Returns:
+//   - `error`: an `apiKeyScopeError` if the key is not scoped for the permission or event (nil if it is, or no API key was presented)
+func apiKeyAllows(space *handlerutil.HandlerWorkspace, event models.EventRecord, p eventPermission) error {
+	var record models.APIKeyRecord
+
+	if err := space.Get(activeAPIKey, &record); errors.Is(err, handlerutil.ErrKeyNotExists) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(record.Events) > 0 && !slices.Contains(record.Events, event.ID) {
+		return apiKeyScopeError{}
+	}
+
+	for _, scope := range record.Scopes {
+		if slices.Contains(apiKeyScopePermissions[scope], p) {
+			return nil
+		}
+	}
+	return apiKeyScopeError{needs: p}
+}
+
+// Function `requireAPIKeyScope` creates a processing step that checks the API key presented (if any) holds the given scope for every event
+//
+// Used by operations not tied to an existing event (keys restricted to specific events cannot perform them).
+//
+// Parameters:
+//   - scope: the scope the handler requires
+//
+// Returns:
+//   - `func(context.Context, *handlerutil.HandlerWorkspace) error`: the processing step performing the check
+func requireAPIKeyScope(scope string) func(context.Context, *handlerutil.HandlerWorkspace) error {
+	return func(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+		var record models.APIKeyRecord
+
+		log.Printf("[HANDLER]: loading API key from workspace under %q (if any)...", activeAPIKey)
+		if err := space.Get(activeAPIKey, &record); errors.Is(err, handlerutil.ErrKeyNotExists) {
+			return nil
+		} else if err != nil {
+			log.Printf("[HANDLER]: error loading API key (%s)", err.Error())
+			return err
+		}
+
+		if len(record.Events) > 0 || !slices.Contains(record.Scopes, scope) {
+			log.Printf("[HANDLER]: API key (_id=%q) lacks the %q scope, rejecting request", record.ID.Hex(), scope)
+			return apiKeyScopeError{scope: scope}
+		}
+		return nil
+	}
+}
+
+// Function `ensureAPIKeyIndexes` creates the indexes looking up API keys by hash and owner and removing them once they expire
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the indexes (nil if no issue occurred)
+func ensureAPIKeyIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.APIKeyQueryContext.Database).
+		Collection(models.APIKeyQueryContext.Collection).
+		Indexes().
+		CreateMany(ctx, []mongo.IndexModel{
+			{Keys: bson.D{{Key: "hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "created_at", Value: -1}}},
+			{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		})
+	return err
+}
+
+// Function `isAPIKeyError` determines if the given error is a rejected API key or an action outside its scopes
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isAPIKeyError(e error) (error, bool) {
+	if rejected, ok := errors.AsType[apiKeyScopeError](e); ok {
+		return handlerutil.ErrNoAccess(
+			handlerutil.NewDetail("scope", rejected.Error()),
+		), true
+	}
+	switch {
+	case errors.Is(e, ErrInvalidAPIKey):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("token", e.Error()),
+		), true
+	case errors.Is(e, ErrAPIKeyNotAccepted):
+		return handlerutil.ErrNoAccess(
+			handlerutil.NewDetail("token", e.Error()),
+		), true
+	}
+	return nil, false
+}
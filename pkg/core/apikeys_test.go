@@ -0,0 +1,278 @@
+package core
+
+/*
+ * File: pkg/core/apikeys_test.go
+ *
+ * Purpose: unit tests for API key issuance, validation and scopes
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const testAPIKey = "tbk_ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+func findAPIKeyOk(scopes ...string) bson.D {
+	return bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.api_keys"},
+			{Key: "firstBatch", Value: bson.A{
+				bson.M{
+					"_id":        bson.NewObjectID(),
+					"hash":       fmt.Sprintf("%x", sha256.Sum256([]byte(testAPIKey))),
+					"hint":       testAPIKey[:apiKeyHintLength],
+					"owner":      bson.NewObjectID(),
+					"name":       "scoreboard bot",
+					"scopes":     scopes,
+					"created_at": time.Now().UTC(),
+				},
+			}},
+		}},
+	}
+}
+
+var findVerifiedOwnerOk = bson.D{
+	{Key: "ok", Value: 1},
+	{Key: "cursor", Value: bson.D{
+		{Key: "id", Value: int64(0)},
+		{Key: "ns", Value: "tournabyte.users"},
+		{Key: "firstBatch", Value: bson.A{
+			bson.M{
+				"_id":            bson.NewObjectID(),
+				"login_email":    "testuser@example.io",
+				"email_verified": true,
+				"metadata": bson.M{
+					"active":     true,
+					"created_at": time.Now().UTC(),
+					"updated_at": time.Now().UTC(),
+				},
+			},
+		}},
+	}},
+}
+
+func presentAPIKey(space *handlerutil.HandlerWorkspace, raw string) {
+	var bindings handlerutil.Bindings
+	_ = space.Get(handlerutil.RequestBindings, &bindings)
+
+	bindings.Headers = func(a any) error {
+		header, ok := a.(*models.AuthorizationHeaderContent)
+		if !ok {
+			return handlerutil.ErrNotAssignable
+		}
+		header.Token = raw
+		return nil
+	}
+	space.Set(handlerutil.RequestBindings, bindings)
+}
+
+func TestAPIKeyManagementPipeline(t *testing.T) {
+	t.Run("KeyIssued", func(t *testing.T) {
+		var result models.IssuedAPIKey
+		pCtx, pCancel, pIn, pOut := apiKeyCreationPipeline(setupWorkingAdminContext(t, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		event := bson.NewObjectID()
+		space := setupWorkingEventCreationWorkspace(t)
+		bindBodyTo(space, models.NewAPIKeyRequest{
+			Name:      "scoreboard bot",
+			Scopes:    []string{models.APIKeyScopeScore, models.APIKeyScopeRead, models.APIKeyScopeScore},
+			Events:    []string{event.Hex()},
+			ExpiresIn: 30,
+		})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(apiKeyResponseKey, &result))
+
+		assert.True(t, strings.HasPrefix(result.Key, apiKeyPrefix))
+		assert.True(t, strings.HasPrefix(result.Key, result.Hint))
+		assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(result.Key))), result.Hash)
+		assert.Equal(t, []string{models.APIKeyScopeRead, models.APIKeyScopeScore}, result.Scopes)
+		assert.Equal(t, []bson.ObjectID{event}, result.Events)
+		require.NotNil(t, result.ExpiresAt)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *result.ExpiresAt, time.Minute)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("KeysListed", func(t *testing.T) {
+		var result []models.APIKeyRecord
+		pCtx, pCancel, pIn, pOut := listAPIKeysPipeline(setupWorkingAdminContext(t, findAPIKeyOk(models.APIKeyScopeRead)))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(apiKeyResponseKey, &result))
+
+		require.Len(t, result, 1)
+		assert.Equal(t, testAPIKey[:apiKeyHintLength], result[0].Hint)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("KeyRevoked", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := revokeAPIKeyPipeline(setupWorkingAdminContext(t, deleteOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, models.APIKeyLookupRequest{ID: bson.NewObjectID().Hex()}, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		assert.NoError(t, after.Get(apiKeyResponseKey, new(gin.H)))
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UnknownKeyNotRevoked", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := revokeAPIKeyPipeline(setupWorkingAdminContext(t, bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 0}}))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, models.APIKeyLookupRequest{ID: bson.NewObjectID().Hex()}, nil)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), mongo.ErrNoDocuments)
+	})
+}
+
+func TestAPIKeyValidation(t *testing.T) {
+	t.Run("ManageScopeCreatesEvent", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventCreationPipeline(setupWorkingAdminContext(t, findAPIKeyOk(models.APIKeyScopeManage), findVerifiedOwnerOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingEventCreationWorkspace(t)
+		presentAPIKey(space, testAPIKey)
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+
+		var role string
+		require.NoError(t, after.Get(activeUserRole, &role))
+		assert.Equal(t, models.AccountRoleUser, role)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("ReadScopeCannotCreateEvent", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventCreationPipeline(setupWorkingAdminContext(t, findAPIKeyOk(models.APIKeyScopeRead), findVerifiedOwnerOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingEventCreationWorkspace(t)
+		presentAPIKey(space, testAPIKey)
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		_, rejected := isAPIKeyError(context.Cause(pCtx))
+		assert.True(t, rejected)
+	})
+
+	t.Run("UnknownKeyRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventCreationPipeline(setupWorkingAdminContext(t, findNoUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingEventCreationWorkspace(t)
+		presentAPIKey(space, testAPIKey)
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidAPIKey)
+	})
+
+	t.Run("DeactivatedOwnerRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventCreationPipeline(setupWorkingAdminContext(t, findAPIKeyOk(models.APIKeyScopeManage), findNoUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingEventCreationWorkspace(t)
+		presentAPIKey(space, testAPIKey)
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidAPIKey)
+	})
+}
+
+func TestAPIKeyAllows(t *testing.T) {
+	event := models.EventRecord{ID: bson.NewObjectID()}
+
+	t.Run("NoKeyPresented", func(t *testing.T) {
+		space := handlerutil.DefaultWorkspace()
+		assert.NoError(t, apiKeyAllows(&space, event, permManageStaff))
+	})
+
+	t.Run("ScoreScope", func(t *testing.T) {
+		space := handlerutil.DefaultWorkspace()
+		space.Set(activeAPIKey, models.APIKeyRecord{Scopes: []string{models.APIKeyScopeScore}})
+
+		assert.NoError(t, apiKeyAllows(&space, event, permScoreMatches))
+		assert.NoError(t, apiKeyAllows(&space, event, permViewEvent))
+		assert.ErrorAs(t, apiKeyAllows(&space, event, permManageMatches), new(apiKeyScopeError))
+	})
+
+	t.Run("EventRestriction", func(t *testing.T) {
+		space := handlerutil.DefaultWorkspace()
+		space.Set(activeAPIKey, models.APIKeyRecord{Scopes: []string{models.APIKeyScopeManage}, Events: []bson.ObjectID{event.ID}})
+
+		assert.NoError(t, apiKeyAllows(&space, event, permManageMatches))
+		assert.ErrorAs(t, apiKeyAllows(&space, models.EventRecord{ID: bson.NewObjectID()}, permViewEvent), new(apiKeyScopeError))
+	})
+}
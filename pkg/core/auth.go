@@ -13,17 +13,18 @@ package core
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/alexedwards/argon2id"
 	"github.com/gin-gonic/gin"
-	"github.com/go-jose/go-jose/v4"
 	"github.com/go-jose/go-jose/v4/jwt"
 	"github.com/go-playground/validator/v10"
 	"github.com/tournabyte/webapi/pkg/dbx"
@@ -44,10 +45,16 @@ const (
 	userAccountRecordKey         = "userAccountRecord"
 	userSessionRecordKey         = "userSessionRecord"
 	accessTokenKey               = "userAccessToken"
+	accessTokenIDKey             = "userAccessTokenID"
 	refreshTokenKey              = "userRefreshToken"
 	activeUserID                 = "activeUserID"
+	activeUserRole               = "activeUserRole"
+	activeUserVerified           = "activeUserVerified"
 	activeSessionID              = "activeSessionID"
 	activeAccessToken            = "activeAccessToken"
+	requestClientKey             = "requestClientDetails"
+	activeSessionFamily          = "activeSessionFamily"
+	activeTokenID                = "activeTokenID"
 )
 
 // Errors specific to authentication/authorization workflow tasks
@@ -56,6 +63,7 @@ var (
 	ErrRefreshTokenAlreadyUsed = errors.New("this token has already been used")
 	ErrRefreshTokenExpired     = errors.New("this token is expired")
 	ErrRefreshTokenNotYetValid = errors.New("too early to use this token")
+	ErrAdminRoleRequired       = errors.New("platform administrator role required")
 )
 
 // Function `(*tournabyteAPIService).initUserCreationWorkspace` initializes the handler workspace for a user creation request handling sequence
@@ -72,6 +80,10 @@ func (srv *tournabyteAPIService) initAuthWorkspace(ctx *gin.Context) *handleruti
 	space.Set(handlerutil.RequestBindings, bind)
 	space.Set(authSessionOptionsKey, srv.getSessionConfig())
 	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(emailVerificationOptionsKey, srv.getEmailVerificationConfig())
+	space.Set(mfaOptionsKey, srv.getMFAConfig())
+	space.Set(loginThrottleKey, srv.throttle)
+	space.Set(requestClientKey, models.ClientDetails{UserAgent: ctx.Request.UserAgent(), IPAddress: ctx.ClientIP()})
 
 	log.Printf("[HANDLER]: setup request bindings and token configurations")
 
@@ -95,14 +107,16 @@ func userCreationPipeline(ctx context.Context) (context.Context, context.CancelC
 	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAuthenticationRequestFormat, pipelineInput)
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveAccountRecordFromRequest, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, createAccountRecord, out2)
-	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateCredentials, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, createAccessToken, out4)
-	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, createRefreshToken, out5)
-	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveSessionRecord, out6)
-	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, createSessionRecord, out7)
-	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, populateUserAuthorizationResponse, out8)
-
-	return pipelineCtx, pipelineCancel, pipelineInput, out9
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, createEmailVerificationToken, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, deliverEmailVerificationToken, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateCredentials, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, createAccessToken, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, createRefreshToken, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveSessionRecord, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, createSessionRecord, out9)
+	out11 := handlerutil.Stage(pipelineCtx, pipelineCancel, populateUserAuthorizationResponse, out10)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, out11
 }
 
 // Function `userAuthenticationPipeline` initializes a handling pipeline for user authentication
@@ -120,15 +134,18 @@ func userAuthenticationPipeline(ctx context.Context) (context.Context, context.C
 	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
 
 	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAuthenticationRequestFormat, pipelineInput)
-	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordFromDatabaseByEmail, out1)
-	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateCredentials, out2)
-	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, createAccessToken, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, createRefreshToken, out4)
-	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveSessionRecord, out5)
-	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, createSessionRecord, out6)
-	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, populateUserAuthorizationResponse, out7)
-
-	return pipelineCtx, pipelineCancel, pipelineInput, out8
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, checkLoginThrottle, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, countingLoginFailures(fetchAccountRecordFromDatabaseByEmail), out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, countingLoginFailures(validateCredentials), out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, challengeMFALogin, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(resetLoginThrottle), out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(createAccessToken), out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(createRefreshToken), out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(deriveSessionRecord), out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(createSessionRecord), out9)
+	out11 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(populateUserAuthorizationResponse), out10)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, out11
 }
 
 // Function `sessionRefreshPipeline` initializes a handling pipeline for validating a refresh token and regenerating the access/refresh token pair
@@ -151,7 +168,7 @@ func sessionRefreshPipeline(ctx context.Context) (context.Context, context.Cance
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordFromDatabaseByID, out3)
 	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, createAccessToken, out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, createRefreshToken, out5)
-	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, deleteSessionRecord, out6)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, markSessionRotated, out6)
 	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveSessionRecord, out7)
 	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, createSessionRecord, out8)
 	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, populateUserAuthorizationResponse, out9)
@@ -178,9 +195,11 @@ func sessionClosePipeline(ctx context.Context) (context.Context, context.CancelC
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchSessionRecordFromDatabaseByID, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateRefreshToken, out3)
 	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, deleteSessionRecord, out4)
-	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, confirmLogout, out5)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(sessionRecordTokenScope), out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, revokePresentedAccessToken, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, confirmLogout, out7)
 
-	return pipelineCtx, pipelineCancel, pipelineInput, out6
+	return pipelineCtx, pipelineCancel, pipelineInput, out8
 }
 
 // Function `bindAuthenticationRequestFormat` binds the request body and saves it to the handler workspace for later processing
@@ -398,6 +417,11 @@ func validateCredentials(ctx context.Context, space *handlerutil.HandlerWorkspac
 		return nil
 	}
 
+	if acct.PasswordHash == "" {
+		log.Printf("[HANDLER]: account has no password (logs in through an identity provider)")
+		return ErrInvalidLogin
+	}
+
 	log.Printf("[HANDLER]: comparing password provided in authentication attempt and stored password hash...")
 	if match, err = argon2id.ComparePasswordAndHash(req.Password, acct.PasswordHash); err != nil {
 		log.Printf("[HANDLER]: error comparing password and hash (%s)", err.Error())
@@ -425,6 +449,8 @@ func createAccessToken(ctx context.Context, space *handlerutil.HandlerWorkspace)
 	var publicClaims jwt.Claims
 	var customClaims models.AuthorizationTokenClaims
 	var acct models.UserAccount
+	var parent models.UserSession
+	var family bson.ObjectID
 	var err error
 	var raw string
 
@@ -440,8 +466,22 @@ func createAccessToken(ctx context.Context, space *handlerutil.HandlerWorkspace)
 		return nil
 	}
 
+	log.Printf("[HANDLER]: determining session family the access token is issued under...")
+	if err = space.Get(activeSessionFamily, &family); errors.Is(err, handlerutil.ErrKeyNotExists) {
+		if err = space.Get(userSessionRecordKey, &parent); err == nil && !parent.Family.IsZero() {
+			family = parent.Family
+		} else {
+			family = bson.NewObjectID()
+		}
+		space.Set(activeSessionFamily, family)
+	}
+
 	log.Printf("[HANDLER]: initializing access token claims...")
 	customClaims.Me = acct.ID.Hex()
+	customClaims.Role = accountRole(acct)
+	customClaims.Session = family.Hex()
+	customClaims.EmailVerified = acct.EmailVerified
+	publicClaims.ID = rand.Text()
 	publicClaims.Issuer = opts.Issuer
 	publicClaims.Subject = opts.Subject
 	publicClaims.IssuedAt = jwt.NewNumericDate(issueTime)
@@ -456,6 +496,7 @@ func createAccessToken(ctx context.Context, space *handlerutil.HandlerWorkspace)
 
 	log.Printf("[HANDLER]: saved signed access token into workspace")
 	space.Set(accessTokenKey, raw)
+	space.Set(accessTokenIDKey, publicClaims.ID)
 	return nil
 }
 
@@ -479,6 +520,9 @@ func deriveSessionRecord(ctx context.Context, space *handlerutil.HandlerWorkspac
 	var now = time.Now().UTC()
 	var acct models.UserAccount
 	var sess models.UserSession
+	var parent models.UserSession
+	var family bson.ObjectID
+	var client models.ClientDetails
 	var err error
 	var token string
 	var hash string
@@ -498,12 +542,40 @@ func deriveSessionRecord(ctx context.Context, space *handlerutil.HandlerWorkspac
 		log.Printf("[HANDLER]: error loading refresh token (%s)", err.Error())
 		return err
 	}
+
+	log.Printf("[HANDLER]: loading rotated session from workspace (if any)...")
+	if err = space.Get(userSessionRecordKey, &parent); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading rotated session (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading session family from workspace (if any)...")
+	if err = space.Get(activeSessionFamily, &family); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading session family (%s)", err.Error())
+		return err
+	}
+	if family.IsZero() {
+		family = cmp.Or(parent.Family, bson.NewObjectID())
+	}
+	if parent.CreatedAt.IsZero() {
+		parent.CreatedAt = now
+	}
+
+	log.Printf("[HANDLER]: loading client details from workspace (if any)...")
+	if err = space.Get(requestClientKey, &client); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading client details (%s)", err.Error())
+		return err
+	}
+
 	log.Printf("[HANDLER]: creating hash of refresh token...")
 	hash = fmt.Sprintf("%x", sha256.Sum256(bytes.NewBufferString(token).Bytes()))
 
 	log.Printf("[HANDLER]: initializing user session record...")
 	sess.ID = hash
 	sess.Authorizes = acct.ID
+	sess.Family = family
+	sess.CreatedAt = parent.CreatedAt
+	sess.Client = client
 	sess.NotValidBefore = now
 	sess.NotValidAfter = now.Add(opts.ExpiresIn)
 	sess.Rotated = false
@@ -633,7 +705,7 @@ func fetchAccountRecordFromDatabaseByEmail(ctx context.Context, space *handlerut
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "login_email", Value: attempt.Email}}
+	filter := bson.D{{Key: "login_email", Value: attempt.Email}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	err = sess.Client().
 		Database(models.UserAccountQueryContext.Database).
 		Collection(models.UserAccountQueryContext.Collection).
@@ -683,7 +755,7 @@ func fetchAccountRecordFromDatabaseByID(ctx context.Context, space *handlerutil.
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "_id", Value: userid}}
+	filter := bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	err = sess.Client().
 		Database(models.UserAccountQueryContext.Database).
 		Collection(models.UserAccountQueryContext.Collection).
@@ -754,6 +826,8 @@ func fetchSessionRecordFromDatabaseByID(ctx context.Context, space *handlerutil.
 
 // Function `validateAccessToken` retrieves the raw token from request context, attempts to decode it, and validates the claims presented
 //
+// API keys (identified by their prefix) are accepted in place of an access token and validated by `validateAPIKey` instead.
+//
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
 //   - space: the workspace to utilize
@@ -786,13 +860,25 @@ func validateAccessToken(ctx context.Context, space *handlerutil.HandlerWorkspac
 		return err
 	}
 
+	if strings.HasPrefix(raw, apiKeyPrefix) {
+		log.Printf("[HANDLER]: API key presented in place of an access token")
+		return validateAPIKey(ctx, space, raw)
+	}
+
 	log.Printf("[HANDLER]: parsing access token...")
-	if token, err := jwt.ParseSigned(raw, []jose.SignatureAlgorithm{jose.SignatureAlgorithm(tokenOptions.Algorithm)}); err != nil {
+	if token, err := jwt.ParseSigned(raw, tokenAlgorithms(tokenOptions)); err != nil {
 		log.Printf("[HANDLER]: error parsing access token (%s)", err.Error())
 		return err
 	} else {
+		log.Printf("[HANDLER]: selecting verification key for access token...")
+		key, err := tokenVerificationKey(tokenOptions, token.Headers[0])
+		if err != nil {
+			log.Printf("[HANDLER]: error selecting verification key (%s)", err.Error())
+			return err
+		}
+
 		log.Printf("[HANDLER]: unmarshalling token claims...")
-		if err = token.Claims([]byte(tokenOptions.Key), &publicClaims, &privateClaims); err != nil {
+		if err = token.Claims(key, &publicClaims, &privateClaims); err != nil {
 			log.Printf("[HANDLER]: error unmarshalling token claims (%s)", err.Error())
 			return err
 		}
@@ -809,8 +895,29 @@ func validateAccessToken(ctx context.Context, space *handlerutil.HandlerWorkspac
 			return err
 		}
 
+		if tokenOptions.Revocations != nil {
+			log.Printf("[HANDLER]: checking if token (jti=%q) was revoked...", publicClaims.ID)
+			revoked, err := tokenOptions.Revocations.IsRevoked(ctx, models.TokenIdentity{
+				ID:       publicClaims.ID,
+				User:     privateClaims.Me,
+				Session:  privateClaims.Session,
+				IssuedAt: publicClaims.IssuedAt.Time(),
+			})
+			if err != nil {
+				log.Printf("[HANDLER]: error checking token revocation (%s)", err.Error())
+				return err
+			}
+			if revoked {
+				log.Printf("[HANDLER]: token (jti=%q) was revoked", publicClaims.ID)
+				return ErrAccessTokenRevoked
+			}
+		}
+
 		log.Printf("Token claims successfully validated and token owner noted in workspace under %q", activeUserID)
+		space.Set(activeTokenID, publicClaims.ID)
 		space.Set(activeUserID, privateClaims.Me)
+		space.Set(activeUserRole, cmp.Or(privateClaims.Role, models.AccountRoleUser))
+		space.Set(activeUserVerified, privateClaims.EmailVerified)
 		return nil
 	}
 }
@@ -836,8 +943,8 @@ func validateRefreshToken(ctx context.Context, space *handlerutil.HandlerWorkspa
 
 	log.Printf("[HANDLER]: checking if refresh token has already been used...")
 	if sess.Rotated {
-		log.Printf("[HANDLER]: refresh token has already been used")
-		return ErrRefreshTokenAlreadyUsed
+		log.Printf("[HANDLER]: refresh token has already been used, revoking every session in its family")
+		return refreshTokenReused(ctx, sess)
 	}
 
 	log.Printf("[HANDLER] checking if the refresh token validity window has started...")
@@ -903,8 +1010,66 @@ func deleteSessionRecord(ctx context.Context, space *handlerutil.HandlerWorkspac
 	return nil
 }
 
+// Function `markSessionRotated` flags the specified session record as used so that presenting its refresh token again is detected as reuse
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func markSessionRotated(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var cur models.UserSession
+	var sess *mongo.Session
+	var err error
+	var res *mongo.UpdateResult
+
+	log.Printf("[HANDLER]: loading session details from workspace...")
+	if err = space.Get(userSessionRecordKey, &cur); err != nil {
+		log.Printf("[HANDLER]: error loading session details (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database update operation (_id=%q)", cur.ID)
+	filter := bson.D{{Key: "_id", Value: cur.ID}, {Key: "rotated", Value: false}}
+	res, err = sess.Client().
+		Database(models.UserSessionQueryContext.Database).
+		Collection(models.UserSessionQueryContext.Collection).
+		UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "rotated", Value: true}}}})
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database update (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: session record was rotated by a concurrent request")
+		return ErrRefreshTokenAlreadyUsed
+	}
+
+	log.Printf("[HANDLER]: session record successfully marked as rotated")
+	return nil
+}
+
 func confirmLogout(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
 	log.Printf("[HANDLER]: saved response structure to workspace")
 	space.Set(userLogoutResponseKey, gin.H{"sessionClosed": time.Now().UTC()})
 	return nil
 }
+
+// Function `accountRole` determines the platform-wide role of a user account
+//
+// Parameters:
+//   - acct: the account to check
+//
+// Returns:
+//   - `string`: the role of the account (accounts without a stored role are regular users)
+func accountRole(acct models.UserAccount) string {
+	return cmp.Or(acct.Role, models.AccountRoleUser)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/tournabyte/webapi/pkg/dbx"
 	"github.com/tournabyte/webapi/pkg/handlerutil"
 	"github.com/tournabyte/webapi/pkg/models"
+	"github.com/tournabyte/webapi/pkg/notify"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
 )
@@ -61,6 +62,7 @@ var (
 			"not_valid_before": time.Now().UTC().Add(-time.Hour),
 			"not_valid_after":  time.Now().UTC().Add(time.Hour),
 			"authorizes":       bson.NewObjectID(),
+			"family":           bson.NewObjectID(),
 			"rotated":          false,
 		},
 	}
@@ -72,6 +74,24 @@ var (
 			{Key: "firstBatch", Value: findSessDoc},
 		}},
 	}
+	findRotatedSessDoc = bson.A{
+		bson.M{
+			"_id":              fmt.Sprintf("%x", sha256.Sum256(bytes.NewBufferString("abcdefg").Bytes())),
+			"not_valid_before": time.Now().UTC().Add(-time.Hour),
+			"not_valid_after":  time.Now().UTC().Add(time.Hour),
+			"authorizes":       bson.NewObjectID(),
+			"family":           bson.NewObjectID(),
+			"rotated":          true,
+		},
+	}
+	findRotatedSessionOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.sessions"},
+			{Key: "firstBatch", Value: findRotatedSessDoc},
+		}},
+	}
 )
 
 func setupWorkingUserCreationContext(t *testing.T) context.Context {
@@ -81,6 +101,7 @@ func setupWorkingUserCreationContext(t *testing.T) context.Context {
 		pingResponse,
 		insertOk,
 		insertOk,
+		insertOk,
 	)
 	mockDb, err := dbx.NewMongoConnection(
 		dbx.ConnectionDeployment(m),
@@ -100,7 +121,7 @@ func setupWorkingSessionRefreshContext(t *testing.T) context.Context {
 		pingResponse,
 		findSessionOk,
 		findUserOk,
-		deleteOk,
+		updateOneOk,
 		insertOk,
 	)
 	mockDb, err := dbx.NewMongoConnection(
@@ -115,6 +136,26 @@ func setupWorkingSessionRefreshContext(t *testing.T) context.Context {
 
 }
 
+func setupReusedSessionRefreshContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findRotatedSessionOk,
+		deleteManyOk,
+		insertOk,
+	)
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
 func setupWorkingUserCreationWorkspace(t *testing.T) *handlerutil.HandlerWorkspace {
 	t.Helper()
 	space := handlerutil.DefaultWorkspace()
@@ -151,6 +192,12 @@ func setupWorkingUserCreationWorkspace(t *testing.T) *handlerutil.HandlerWorkspa
 		Signer:    signer,
 	})
 	space.Set(authSessionOptionsKey, models.SessionOptions{ExpiresIn: time.Hour})
+	space.Set(emailVerificationOptionsKey, models.EmailVerificationOptions{
+		ExpiresIn: time.Hour,
+		Notifier: notify.NotifierFunc(func(ctx context.Context, msg notify.Message) error {
+			return nil
+		}),
+	})
 
 	return &space
 }
@@ -254,6 +301,7 @@ func setupWorkingSessionCloseWorkspace(t *testing.T) *handlerutil.HandlerWorkspa
 			},
 		},
 	)
+	space.Set(authTokenOptionsKey, models.TokenOptions{})
 
 	return &space
 
@@ -352,6 +400,20 @@ func TestSessionRefreshPipeline(t *testing.T) {
 		default:
 		}
 	})
+
+	t.Run("ReusedTokenRevokesFamily", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := sessionRefreshPipeline(setupReusedSessionRefreshContext(t))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingSessionRefreshWorkspace(t)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.Equal(t, ErrRefreshTokenAlreadyUsed, context.Cause(pCtx), "Revocation should complete without additional errors")
+	})
 }
 
 func TestSessionClosePipeline(t *testing.T) {
@@ -0,0 +1,593 @@
+package core
+
+/*
+ * File: pkg/core/brackets.go
+ *
+ * Purpose: match set construction for the supported competition formats
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Errors specific to match set construction tasks
+var (
+	ErrDuplicateSeed = errors.New("participants share the same seed")
+)
+
+// Type `matchSlot` represents the value populating one side (home or away) of a match
+//
+// Members:
+//   - id: the participant ID or the ID of the match the slot is sourced from
+//   - ref: states whether `id` refers to a participant, a match winner, a match loser or a bye
+type matchSlot struct {
+	id  bson.ObjectID
+	ref string
+}
+
+// Function `byeSlot` creates a slot that will never be populated by a participant
+//
+// Returns:
+//   - `matchSlot`: the bye slot
+func byeSlot() matchSlot {
+	return matchSlot{id: bson.NilObjectID, ref: models.ParticipantFieldReferencesBye}
+}
+
+// Function `playerSlot` creates a slot populated by the given participant
+//
+// Parameters:
+//   - id: the participant ID populating the slot
+//
+// Returns:
+//   - `matchSlot`: the participant slot
+func playerSlot(id bson.ObjectID) matchSlot {
+	return matchSlot{id: id, ref: models.ParticipantFieldReferencesPlayer}
+}
+
+// Function `winnerSlot` creates a slot populated by the winner of the given match
+//
+// Parameters:
+//   - m: the match the slot is sourced from
+//
+// Returns:
+//   - `matchSlot`: a participant slot if the winner is already known, a bye slot if the match can never be played, otherwise a match reference
+func winnerSlot(m models.EventMatch) matchSlot {
+	if m.Winner != bson.NilObjectID {
+		return playerSlot(m.Winner)
+	}
+	if m.HomeRef == models.ParticipantFieldReferencesBye && m.AwayRef == models.ParticipantFieldReferencesBye {
+		return byeSlot()
+	}
+	return matchSlot{id: m.ID, ref: models.ParticipantFieldReferencesMatch}
+}
+
+// Function `loserSlot` creates a slot populated by the loser of the given match
+//
+// Parameters:
+//   - m: the match the slot is sourced from
+//
+// Returns:
+//   - `matchSlot`: a bye slot if the match was decided by a bye (no one was eliminated), otherwise a loser reference
+func loserSlot(m models.EventMatch) matchSlot {
+	if m.HomeRef == models.ParticipantFieldReferencesBye || m.AwayRef == models.ParticipantFieldReferencesBye {
+		return byeSlot()
+	}
+	return matchSlot{id: m.ID, ref: models.ParticipantFieldReferencesLoser}
+}
+
+// Function `newMatch` creates a match record between the given slots, awarding the match immediately when a participant is facing a bye
+//
+// Parameters:
+//   - event: the event the match takes place during
+//   - bracket: the bracket label of the match
+//   - home: the slot populating the home side
+//   - away: the slot populating the away side
+//
+// Returns:
+//   - `models.EventMatch`: the match record
+func newMatch(event bson.ObjectID, bracket string, home matchSlot, away matchSlot) models.EventMatch {
+	match := models.EventMatch{
+		ID:               bson.NewObjectID(),
+		HomeParticipant:  home.id,
+		HomeRef:          home.ref,
+		AwayParticipant:  away.id,
+		AwayRef:          away.ref,
+		Bracket:          bracket,
+		TakesPlaceDuring: event,
+	}
+
+	if home.ref == models.ParticipantFieldReferencesMatch || home.ref == models.ParticipantFieldReferencesLoser {
+		match.HomeSource, match.HomeSourceRef = home.id, home.ref
+	}
+	if away.ref == models.ParticipantFieldReferencesMatch || away.ref == models.ParticipantFieldReferencesLoser {
+		match.AwaySource, match.AwaySourceRef = away.id, away.ref
+	}
+
+	if home.ref == models.ParticipantFieldReferencesPlayer && away.ref == models.ParticipantFieldReferencesBye {
+		match.Winner = home.id
+	}
+	if away.ref == models.ParticipantFieldReferencesPlayer && home.ref == models.ParticipantFieldReferencesBye {
+		match.Winner = away.id
+	}
+
+	return match
+}
+
+// Function `isSeedingError` determines if the given error is a match set that cannot be built from the seeds assigned to the participants
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isSeedingError(e error) (error, bool) {
+	if errors.Is(e, ErrDuplicateSeed) {
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("seed", e.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `bracketPlacement` lists the seed occupying each first round position of a bracket so that the top seeds meet as late as possible
+//
+// Every first round match pairs seed `s` with seed `slots+1-s` and every later round pairs the winners of matches whose seed totals are equal (e.g. for 8 slots the
+// order is 1, 8, 4, 5, 2, 7, 3, 6).
+//
+// Parameters:
+//   - slots: the number of first round positions (a power of two)
+//
+// Returns:
+//   - `[]int`: the seed (starting at 1) of every position
+func bracketPlacement(slots int) []int {
+	placement := []int{1}
+	for size := 2; size <= slots; size *= 2 {
+		next := make([]int, 0, size)
+		for _, seed := range placement {
+			next = append(next, seed, size+1-seed)
+		}
+		placement = next
+	}
+	return placement
+}
+
+// Function `seededParticipantOrder` orders participants by seed, placing unseeded participants behind the seeded ones
+//
+// Unseeded participants are kept in registration order, or shuffled with a generator initialized from `drawSeed` when it is non-zero so that the same draw can
+// be reproduced later.
+//
+// Parameters:
+//   - participants: the participants to order
+//   - drawSeed: the random number generator seed to shuffle unseeded participants with (zero to keep registration order)
+//
+// Returns:
+//   - `[]models.EventParticipant`: the participants ordered from the top seed down
+//   - `error`: `ErrDuplicateSeed` if two participants share a seed
+func seededParticipantOrder(participants []models.EventParticipant, drawSeed int64) ([]models.EventParticipant, error) {
+	seeded := make([]models.EventParticipant, 0, len(participants))
+	unseeded := make([]models.EventParticipant, 0, len(participants))
+	for _, p := range participants {
+		if p.Seed > 0 {
+			seeded = append(seeded, p)
+		} else {
+			unseeded = append(unseeded, p)
+		}
+	}
+
+	slices.SortFunc(seeded, func(a, b models.EventParticipant) int {
+		return cmp.Compare(a.Seed, b.Seed)
+	})
+	for i := 1; i < len(seeded); i++ {
+		if seeded[i].Seed == seeded[i-1].Seed {
+			return nil, ErrDuplicateSeed
+		}
+	}
+
+	slices.SortFunc(unseeded, func(a, b models.EventParticipant) int {
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+	if drawSeed != 0 {
+		rng := rand.New(rand.NewPCG(uint64(drawSeed), 0))
+		rng.Shuffle(len(unseeded), func(i, j int) {
+			unseeded[i], unseeded[j] = unseeded[j], unseeded[i]
+		})
+	}
+
+	return append(seeded, unseeded...), nil
+}
+
+// Function `singleEliminationMatchSet` lays out a single-elimination bracket as a binary heap (the final is at index 0, the feeders of match `i` are at `2i+1` and `2i+2`)
+//
+// Parameters:
+//   - event: the event the matches take place during
+//   - participants: the participants competing in the bracket, ordered from the top seed down
+//
+// Returns:
+//   - `[]models.EventMatch`: the matches of the bracket, in heap order
+func singleEliminationMatchSet(event bson.ObjectID, participants []models.EventParticipant) []models.EventMatch {
+	matchCount := (1 << bits.Len(uint(len(participants)-1))) - 1
+	matchList := make([]models.EventMatch, 0, matchCount)
+
+	rounds := bits.Len(uint(matchCount))
+	for i := 0; i < matchCount; i++ {
+		matchList = append(matchList, models.EventMatch{
+			ID:               bson.NewObjectID(),
+			Round:            rounds - (bits.Len(uint(i+1)) - 1),
+			TakesPlaceDuring: event,
+		})
+	}
+
+	for i := 0; i < matchCount; i++ {
+		awayIdx := 2*i + 1
+		if awayIdx >= 0 && awayIdx < matchCount {
+			matchList[i].AwayParticipant = matchList[awayIdx].ID
+			matchList[i].AwayRef = models.ParticipantFieldReferencesMatch
+			matchList[i].AwaySource = matchList[awayIdx].ID
+			matchList[i].AwaySourceRef = models.ParticipantFieldReferencesMatch
+		}
+
+		homeIdx := 2*i + 2
+		if homeIdx >= 0 && homeIdx < matchCount {
+			matchList[i].HomeParticipant = matchList[homeIdx].ID
+			matchList[i].HomeRef = models.ParticipantFieldReferencesMatch
+			matchList[i].HomeSource = matchList[homeIdx].ID
+			matchList[i].HomeSourceRef = models.ParticipantFieldReferencesMatch
+		}
+	}
+
+	placement := bracketPlacement(matchCount + 1)
+	for i := matchCount / 2; i < matchCount; i++ {
+		position := 2 * (i - matchCount/2)
+		home := placement[position] - 1
+		away := placement[position+1] - 1
+
+		if home >= 0 && home < len(participants) {
+			matchList[i].HomeParticipant = participants[home].ID
+			matchList[i].HomeRef = models.ParticipantFieldReferencesPlayer
+		} else {
+			matchList[i].HomeParticipant = bson.NilObjectID
+			matchList[i].HomeRef = models.ParticipantFieldReferencesBye
+		}
+
+		if away >= 0 && away < len(participants) {
+			matchList[i].AwayParticipant = participants[away].ID
+			matchList[i].AwayRef = models.ParticipantFieldReferencesPlayer
+		} else {
+			matchList[i].AwayParticipant = bson.NilObjectID
+			matchList[i].AwayRef = models.ParticipantFieldReferencesBye
+		}
+	}
+
+	for i := matchCount - 1; i >= 0; i-- {
+		if matchList[i].AwayParticipant == bson.NilObjectID && matchList[i].HomeParticipant != bson.NilObjectID {
+			matchList[i].Winner = matchList[i].HomeParticipant
+		}
+		if matchList[i].AwayParticipant != bson.NilObjectID && matchList[i].HomeParticipant == bson.NilObjectID {
+			matchList[i].Winner = matchList[i].AwayParticipant
+		}
+		if matchList[i].HomeRef == models.ParticipantFieldReferencesMatch {
+			feederIdx := 2*i + 2
+			if feederIdx >= 0 && feederIdx < matchCount && matchList[feederIdx].Winner != bson.NilObjectID {
+				matchList[i].HomeParticipant = matchList[feederIdx].Winner
+				matchList[i].HomeRef = models.ParticipantFieldReferencesPlayer
+			}
+		}
+		if matchList[i].AwayRef == models.ParticipantFieldReferencesMatch {
+			feederIdx := 2*i + 1
+			if feederIdx >= 0 && feederIdx < matchCount && matchList[feederIdx].Winner != bson.NilObjectID {
+				matchList[i].AwayParticipant = matchList[feederIdx].Winner
+				matchList[i].AwayRef = models.ParticipantFieldReferencesPlayer
+			}
+		}
+	}
+
+	return matchList
+}
+
+// Function `doubleEliminationMatchSet` lays out a winners bracket, a losers bracket fed by the winners bracket losers and a grand final between both bracket champions
+//
+// The losers bracket alternates between rounds where the survivors face the players dropping down from the next winners bracket round and rounds where the
+// survivors face each other. The drop-down order is reversed every other round so that players eliminated on the same side of the winners bracket do not
+// meet again straight away. A losers bracket pairing left with a bye (because the winners bracket match feeding it was decided by a bye) is not created at
+// all; the other side moves on to the next losers bracket round directly so that no losers bracket match ever waits on a participant that will never arrive.
+//
+// Parameters:
+//   - event: the event the matches take place during
+//   - participants: the participants competing in the bracket
+//   - reset: whether a reset match follows the grand final (only played if the losers bracket champion wins the grand final)
+//
+// Returns:
+//   - `[]models.EventMatch`: the matches of the winners bracket (in heap order) followed by the losers bracket and grand final matches
+func doubleEliminationMatchSet(event bson.ObjectID, participants []models.EventParticipant, reset bool) []models.EventMatch {
+	matchList := singleEliminationMatchSet(event, participants)
+	rounds := bits.Len(uint(len(matchList)))
+
+	for i := range matchList {
+		matchList[i].Bracket = models.BracketWinners
+	}
+
+	winnersRound := func(r int) []models.EventMatch {
+		depth := rounds - r
+		return matchList[(1<<depth)-1 : (1<<(depth+1))-1]
+	}
+
+	play := func(round int, home matchSlot, away matchSlot) matchSlot {
+		if home.ref == models.ParticipantFieldReferencesBye {
+			return away
+		}
+		if away.ref == models.ParticipantFieldReferencesBye {
+			return home
+		}
+		m := newMatch(event, models.BracketLosers, home, away)
+		m.Round = round
+		matchList = append(matchList, m)
+		return winnerSlot(m)
+	}
+
+	var champion matchSlot
+	if rounds == 1 {
+		champion = loserSlot(matchList[0])
+	} else {
+		losersRound := 1
+		entrants := make([]matchSlot, 0)
+		opening := winnersRound(1)
+		for i := 0; i+1 < len(opening); i += 2 {
+			entrants = append(entrants, play(losersRound, loserSlot(opening[i]), loserSlot(opening[i+1])))
+		}
+
+		for r := 2; r <= rounds; r++ {
+			drops := make([]matchSlot, 0)
+			for _, m := range winnersRound(r) {
+				drops = append(drops, loserSlot(m))
+			}
+			if r%2 == 0 {
+				slices.Reverse(drops)
+			}
+
+			losersRound++
+			survivors := make([]matchSlot, 0)
+			for i := range entrants {
+				survivors = append(survivors, play(losersRound, entrants[i], drops[i]))
+			}
+			entrants = survivors
+
+			if r == rounds {
+				break
+			}
+
+			losersRound++
+			survivors = make([]matchSlot, 0)
+			for i := 0; i+1 < len(entrants); i += 2 {
+				survivors = append(survivors, play(losersRound, entrants[i], entrants[i+1]))
+			}
+			entrants = survivors
+		}
+		champion = entrants[0]
+	}
+
+	final := newMatch(event, models.BracketGrandFinal, winnerSlot(matchList[0]), champion)
+	final.Round = 1
+	matchList = append(matchList, final)
+
+	if reset {
+		rematch := newMatch(
+			event,
+			models.BracketGrandFinalReset,
+			matchSlot{id: final.ID, ref: models.ParticipantFieldReferencesMatch},
+			matchSlot{id: final.ID, ref: models.ParticipantFieldReferencesLoser},
+		)
+		rematch.Round = 1
+		matchList = append(matchList, rematch)
+	}
+
+	return matchList
+}
+
+// Function `roundRobinMatchSet` pairs every participant of a group against every other participant of the same group using the circle method
+//
+// Participants are dealt into groups in snake order (1, 2, ..., N, N, ..., 2, 1, ...). Within a group the first participant stays fixed while the others
+// rotate one position per round; groups with an odd number of participants rotate an empty seat as well, and whoever is paired with it sits the round out.
+//
+// Parameters:
+//   - event: the event the matches take place during
+//   - participants: the participants competing in the event
+//   - groups: the number of groups to split the participants into
+//
+// Returns:
+//   - `[]models.EventMatch`: the matches of every group, ordered by group then round
+func roundRobinMatchSet(event bson.ObjectID, participants []models.EventParticipant, groups int) []models.EventMatch {
+	matchList := make([]models.EventMatch, 0)
+	members := make([][]matchSlot, groups)
+
+	for i, p := range participants {
+		row, col := i/groups, i%groups
+		if row%2 == 1 {
+			col = groups - 1 - col
+		}
+		members[col] = append(members[col], playerSlot(p.ID))
+	}
+
+	for g, seats := range members {
+		if len(seats)%2 == 1 {
+			seats = append(seats, byeSlot())
+		}
+
+		for r := 1; r < len(seats); r++ {
+			for i := 0; i < len(seats)/2; i++ {
+				home, away := seats[i], seats[len(seats)-1-i]
+				if home.ref == models.ParticipantFieldReferencesBye || away.ref == models.ParticipantFieldReferencesBye {
+					continue
+				}
+				if i == 0 && r%2 == 0 {
+					home, away = away, home
+				}
+
+				m := newMatch(event, models.BracketRoundRobin, home, away)
+				m.Round = r
+				if groups > 1 {
+					m.Group = g + 1
+				}
+				matchList = append(matchList, m)
+			}
+
+			rotated := append([]matchSlot{seats[0], seats[len(seats)-1]}, seats[1:len(seats)-1]...)
+			seats = rotated
+		}
+	}
+
+	return matchList
+}
+
+// Constant `swissPairingBudget` bounds the number of pairing attempts made before rematches are allowed
+const swissPairingBudget = 100000
+
+// Type `pairingKey` identifies two participants regardless of which one is listed first
+type pairingKey [2]bson.ObjectID
+
+// Function `pairingOf` creates the key identifying the pairing of the two given participants
+//
+// Parameters:
+//   - a: one of the participants
+//   - b: the other participant
+//
+// Returns:
+//   - `pairingKey`: the key identifying the pairing
+func pairingOf(a bson.ObjectID, b bson.ObjectID) pairingKey {
+	if b.Hex() < a.Hex() {
+		a, b = b, a
+	}
+	return pairingKey{a, b}
+}
+
+// Function `pairSwissPlayers` pairs the given participants (ordered by rank) with the closest ranked participant they have not faced yet
+//
+// Parameters:
+//   - ranked: the participants to pair, ordered from highest to lowest rank
+//   - played: the pairings that already took place
+//   - budget: the remaining number of pairing attempts (shared between recursive calls)
+//
+// Returns:
+//   - `[][2]bson.ObjectID`: the pairings found
+//   - `bool`: whether every participant could be paired without a rematch
+func pairSwissPlayers(ranked []bson.ObjectID, played map[pairingKey]bool, budget *int) ([][2]bson.ObjectID, bool) {
+	if len(ranked) == 0 {
+		return nil, true
+	}
+
+	for j := 1; j < len(ranked) && *budget > 0; j++ {
+		*budget--
+		if played[pairingOf(ranked[0], ranked[j])] {
+			continue
+		}
+
+		rest := make([]bson.ObjectID, 0, len(ranked)-2)
+		rest = append(rest, ranked[1:j]...)
+		rest = append(rest, ranked[j+1:]...)
+		if pairs, ok := pairSwissPlayers(rest, played, budget); ok {
+			return append([][2]bson.ObjectID{{ranked[0], ranked[j]}}, pairs...), true
+		}
+	}
+
+	return nil, false
+}
+
+// Function `swissRoundMatchSet` pairs the participants for the next round of a swiss event
+//
+// Participants are ranked by their standings after the previous rounds and paired with the closest ranked opponent they have not faced yet. With an odd
+// number of participants the lowest ranked participant without a bye so far sits the round out and is awarded the win. Rematches are only allowed when no
+// pairing without one exists.
+//
+// Parameters:
+//   - event: the event the matches take place during
+//   - participants: the participants competing in the event
+//   - previous: the matches of the previous rounds
+//   - round: the number of the round being generated
+//
+// Returns:
+//   - `[]models.EventMatch`: the matches of the round, the bye (if any) being last
+func swissRoundMatchSet(event bson.ObjectID, participants []models.EventParticipant, previous []models.EventMatch, round int) []models.EventMatch {
+	played := make(map[pairingKey]bool)
+	hadBye := make(map[bson.ObjectID]bool)
+	for _, m := range previous {
+		switch {
+		case isAwardedBye(m):
+			hadBye[m.Winner] = true
+		case m.HomeRef == models.ParticipantFieldReferencesPlayer && m.AwayRef == models.ParticipantFieldReferencesPlayer:
+			played[pairingOf(m.HomeParticipant, m.AwayParticipant)] = true
+		}
+	}
+
+	ranked := make([]bson.ObjectID, 0, len(participants))
+	for _, s := range computeStandings(participants, previous) {
+		ranked = append(ranked, s.Participant)
+	}
+
+	without := func(i int) []bson.ObjectID {
+		rest := make([]bson.ObjectID, 0, len(ranked)-1)
+		rest = append(rest, ranked[:i]...)
+		return append(rest, ranked[i+1:]...)
+	}
+
+	bye := -1
+	var pairs [][2]bson.ObjectID
+	budget := swissPairingBudget
+	if len(ranked)%2 == 1 {
+		for i := len(ranked) - 1; i >= 0 && bye < 0; i-- {
+			if hadBye[ranked[i]] {
+				continue
+			}
+			if found, ok := pairSwissPlayers(without(i), played, &budget); ok {
+				bye, pairs = i, found
+			}
+		}
+		if bye < 0 {
+			bye = len(ranked) - 1
+			for i := len(ranked) - 1; i >= 0; i-- {
+				if !hadBye[ranked[i]] {
+					bye = i
+					break
+				}
+			}
+		}
+	} else if found, ok := pairSwissPlayers(ranked, played, &budget); ok {
+		pairs = found
+	}
+
+	if pairs == nil {
+		remaining := ranked
+		if bye >= 0 {
+			remaining = without(bye)
+		}
+		unrestricted := len(remaining)
+		pairs, _ = pairSwissPlayers(remaining, map[pairingKey]bool{}, &unrestricted)
+	}
+
+	matchList := make([]models.EventMatch, 0, len(pairs)+1)
+	for _, p := range pairs {
+		m := newMatch(event, models.BracketSwiss, playerSlot(p[0]), playerSlot(p[1]))
+		m.Round = round
+		matchList = append(matchList, m)
+	}
+	if bye >= 0 {
+		m := newMatch(event, models.BracketSwiss, playerSlot(ranked[bye]), byeSlot())
+		m.Round = round
+		matchList = append(matchList, m)
+	}
+
+	return matchList
+}
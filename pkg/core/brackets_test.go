@@ -0,0 +1,382 @@
+package core
+
+/*
+ * File: pkg/core/brackets_test.go
+ *
+ * Purpose: unit tests for the match set construction logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func generateParticipants(t *testing.T, event bson.ObjectID, n int) []models.EventParticipant {
+	t.Helper()
+
+	participants := make([]models.EventParticipant, 0, n)
+	for i := range n {
+		participants = append(participants, models.EventParticipant{
+			ID:             bson.NewObjectID(),
+			DisplayName:    fmt.Sprintf("Player %d", i+1),
+			ParticipatesIn: event,
+		})
+	}
+	return participants
+}
+
+func requireConsistentReferences(t *testing.T, matches []models.EventMatch) {
+	t.Helper()
+
+	ids := make(map[bson.ObjectID]bool)
+	for _, m := range matches {
+		ids[m.ID] = true
+	}
+
+	used := make(map[string]int)
+	for _, m := range matches {
+		sources := []matchSlot{{m.HomeSource, m.HomeSourceRef}, {m.AwaySource, m.AwaySourceRef}}
+		for i, slot := range []matchSlot{{m.HomeParticipant, m.HomeRef}, {m.AwayParticipant, m.AwayRef}} {
+			switch slot.ref {
+			case models.ParticipantFieldReferencesMatch, models.ParticipantFieldReferencesLoser:
+				require.True(t, ids[slot.id], "slot references a match outside of the match set")
+				require.Equal(t, slot, sources[i], "slot does not record the match it is sourced from")
+				used[slot.ref+slot.id.Hex()]++
+			case models.ParticipantFieldReferencesBye:
+				require.Equal(t, bson.NilObjectID, slot.id)
+			}
+		}
+	}
+
+	for ref, count := range used {
+		require.Equal(t, 1, count, "match outcome %s feeds more than one slot", ref)
+	}
+}
+
+func TestSingleEliminationMatchSet(t *testing.T) {
+	event := bson.NewObjectID()
+
+	for _, n := range []int{2, 3, 5, 8, 13} {
+		t.Run(fmt.Sprintf("%dParticipants", n), func(t *testing.T) {
+			participants := generateParticipants(t, event, n)
+			matches := singleEliminationMatchSet(event, participants)
+
+			assert.Len(t, matches, (1<<bits.Len(uint(n-1)))-1)
+			requireConsistentReferences(t, matches)
+
+			seeded := make(map[bson.ObjectID]bool)
+			for _, m := range matches[len(matches)/2:] {
+				if m.HomeRef == models.ParticipantFieldReferencesPlayer {
+					seeded[m.HomeParticipant] = true
+				}
+				if m.AwayRef == models.ParticipantFieldReferencesPlayer {
+					seeded[m.AwayParticipant] = true
+				}
+			}
+			assert.Len(t, seeded, n)
+		})
+	}
+
+	t.Run("TopSeedsMeetInFinal", func(t *testing.T) {
+		participants := generateParticipants(t, event, 16)
+		matches := singleEliminationMatchSet(event, participants)
+
+		half := func(id bson.ObjectID) int {
+			for i, m := range matches {
+				if m.HomeParticipant == id || m.AwayParticipant == id {
+					for i > 2 {
+						i = (i - 1) / 2
+					}
+					return i
+				}
+			}
+			return -1
+		}
+
+		assert.NotEqual(t, half(participants[0].ID), half(participants[1].ID))
+		assert.Equal(t, half(participants[0].ID), half(participants[3].ID))
+	})
+}
+
+func TestBracketPlacement(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, bracketPlacement(2))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, bracketPlacement(8))
+
+	placement := bracketPlacement(16)
+	for i := 0; i < len(placement); i += 2 {
+		assert.Equal(t, 17, placement[i]+placement[i+1])
+	}
+}
+
+func TestSeededParticipantOrder(t *testing.T) {
+	event := bson.NewObjectID()
+
+	t.Run("SeededParticipantsFirst", func(t *testing.T) {
+		participants := generateParticipants(t, event, 5)
+		participants[3].Seed = 1
+		participants[1].Seed = 2
+
+		ordered, err := seededParticipantOrder(participants, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, []bson.ObjectID{participants[3].ID, participants[1].ID, participants[0].ID, participants[2].ID, participants[4].ID},
+			[]bson.ObjectID{ordered[0].ID, ordered[1].ID, ordered[2].ID, ordered[3].ID, ordered[4].ID})
+	})
+
+	t.Run("DuplicateSeedsRejected", func(t *testing.T) {
+		participants := generateParticipants(t, event, 3)
+		participants[0].Seed = 1
+		participants[2].Seed = 1
+
+		_, err := seededParticipantOrder(participants, 0)
+		assert.ErrorIs(t, err, ErrDuplicateSeed)
+
+		_, classified := isSeedingError(err)
+		assert.True(t, classified)
+	})
+
+	t.Run("RandomDrawIsReproducible", func(t *testing.T) {
+		participants := generateParticipants(t, event, 16)
+		participants[7].Seed = 1
+		reversed := slices.Clone(participants)
+		slices.Reverse(reversed)
+
+		first, err := seededParticipantOrder(participants, 1234)
+		require.NoError(t, err)
+		second, err := seededParticipantOrder(reversed, 1234)
+		require.NoError(t, err)
+
+		assert.Equal(t, participants[7].ID, first[0].ID)
+		assert.Equal(t, first, second)
+	})
+}
+
+func TestDoubleEliminationMatchSet(t *testing.T) {
+	event := bson.NewObjectID()
+
+	for _, n := range []int{2, 3, 4, 6, 8, 13, 16} {
+		t.Run(fmt.Sprintf("%dParticipants", n), func(t *testing.T) {
+			participants := generateParticipants(t, event, n)
+			slots := 1 << bits.Len(uint(n-1))
+
+			matches := doubleEliminationMatchSet(event, participants, false)
+			assert.Len(t, matches, slots+n-2)
+			assert.Equal(t, models.BracketGrandFinal, matches[len(matches)-1].Bracket)
+			requireConsistentReferences(t, matches)
+
+			counts := make(map[string]int)
+			for _, m := range matches {
+				counts[m.Bracket]++
+				if m.Bracket == models.BracketLosers {
+					assert.NotEqual(t, models.ParticipantFieldReferencesBye, m.HomeRef, "losers bracket match waits on a bye")
+					assert.NotEqual(t, models.ParticipantFieldReferencesBye, m.AwayRef, "losers bracket match waits on a bye")
+				}
+			}
+			assert.Equal(t, slots-1, counts[models.BracketWinners])
+			assert.Equal(t, n-2, counts[models.BracketLosers])
+
+			withReset := doubleEliminationMatchSet(event, participants, true)
+			assert.Len(t, withReset, slots+n-1)
+			reset := withReset[len(withReset)-1]
+			final := withReset[len(withReset)-2]
+			assert.Equal(t, models.BracketGrandFinalReset, reset.Bracket)
+			assert.Equal(t, final.ID, reset.HomeParticipant)
+			assert.Equal(t, models.ParticipantFieldReferencesMatch, reset.HomeRef)
+			assert.Equal(t, final.ID, reset.AwayParticipant)
+			assert.Equal(t, models.ParticipantFieldReferencesLoser, reset.AwayRef)
+		})
+	}
+
+	t.Run("WinnersBracketLosersDropDown", func(t *testing.T) {
+		participants := generateParticipants(t, event, 8)
+		matches := doubleEliminationMatchSet(event, participants, false)
+
+		dropped := make(map[bson.ObjectID]bool)
+		for _, m := range matches {
+			if m.Bracket != models.BracketLosers {
+				continue
+			}
+			if m.HomeRef == models.ParticipantFieldReferencesLoser {
+				dropped[m.HomeParticipant] = true
+			}
+			if m.AwayRef == models.ParticipantFieldReferencesLoser {
+				dropped[m.AwayParticipant] = true
+			}
+		}
+
+		for _, m := range matches {
+			if m.Bracket == models.BracketWinners {
+				assert.True(t, dropped[m.ID], "loser of winners bracket match is never placed in the losers bracket")
+			}
+		}
+	})
+}
+
+func TestRoundRobinMatchSet(t *testing.T) {
+	event := bson.NewObjectID()
+
+	for _, n := range []int{2, 3, 4, 7, 10} {
+		t.Run(fmt.Sprintf("%dParticipants", n), func(t *testing.T) {
+			participants := generateParticipants(t, event, n)
+			matches := roundRobinMatchSet(event, participants, 1)
+
+			assert.Len(t, matches, n*(n-1)/2)
+
+			pairings := make(map[[2]bson.ObjectID]bool)
+			perRound := make(map[int]map[bson.ObjectID]bool)
+			for _, m := range matches {
+				require.Equal(t, models.BracketRoundRobin, m.Bracket)
+				require.Zero(t, m.Group)
+
+				key := [2]bson.ObjectID{m.HomeParticipant, m.AwayParticipant}
+				if m.AwayParticipant.Hex() < m.HomeParticipant.Hex() {
+					key = [2]bson.ObjectID{m.AwayParticipant, m.HomeParticipant}
+				}
+				require.False(t, pairings[key], "participants are paired more than once")
+				pairings[key] = true
+
+				if perRound[m.Round] == nil {
+					perRound[m.Round] = make(map[bson.ObjectID]bool)
+				}
+				require.False(t, perRound[m.Round][m.HomeParticipant], "participant plays twice in a round")
+				require.False(t, perRound[m.Round][m.AwayParticipant], "participant plays twice in a round")
+				perRound[m.Round][m.HomeParticipant] = true
+				perRound[m.Round][m.AwayParticipant] = true
+			}
+
+			assert.Len(t, perRound, n-1+n%2)
+		})
+	}
+
+	t.Run("SplitIntoGroups", func(t *testing.T) {
+		participants := generateParticipants(t, event, 10)
+		matches := roundRobinMatchSet(event, participants, 3)
+
+		groupOf := make(map[bson.ObjectID]int)
+		for _, m := range matches {
+			require.NotZero(t, m.Group)
+			for _, who := range []bson.ObjectID{m.HomeParticipant, m.AwayParticipant} {
+				if g, found := groupOf[who]; found {
+					require.Equal(t, g, m.Group, "participant plays in more than one group")
+				}
+				groupOf[who] = m.Group
+			}
+		}
+
+		assert.Len(t, groupOf, 10)
+		assert.Len(t, matches, 6+3+3)
+	})
+}
+
+func playSwissRound(t *testing.T, matches []models.EventMatch, winnerOf func(m models.EventMatch) bson.ObjectID) []models.EventMatch {
+	t.Helper()
+
+	for i, m := range matches {
+		if m.Winner != bson.NilObjectID {
+			continue
+		}
+		matches[i].Winner = winnerOf(m)
+		if matches[i].Winner == m.HomeParticipant {
+			matches[i].Loser = m.AwayParticipant
+		} else {
+			matches[i].Loser = m.HomeParticipant
+		}
+	}
+	return matches
+}
+
+func TestSwissRoundMatchSet(t *testing.T) {
+	event := bson.NewObjectID()
+
+	for _, n := range []int{2, 5, 8, 11} {
+		t.Run(fmt.Sprintf("%dParticipants", n), func(t *testing.T) {
+			participants := generateParticipants(t, event, n)
+			rounds := bits.Len(uint(n - 1))
+
+			previous := make([]models.EventMatch, 0)
+			pairings := make(map[pairingKey]bool)
+			byes := make(map[bson.ObjectID]bool)
+			for round := 1; round <= rounds; round++ {
+				matches := swissRoundMatchSet(event, participants, previous, round)
+				require.Len(t, matches, (n+1)/2)
+
+				seen := make(map[bson.ObjectID]bool)
+				for _, m := range matches {
+					require.Equal(t, models.BracketSwiss, m.Bracket)
+					require.Equal(t, round, m.Round)
+					require.False(t, seen[m.HomeParticipant], "participant plays twice in a round")
+					seen[m.HomeParticipant] = true
+
+					if m.AwayRef == models.ParticipantFieldReferencesBye {
+						require.Equal(t, m.HomeParticipant, m.Winner, "bye is not awarded as a win")
+						require.False(t, byes[m.HomeParticipant], "participant receives a second bye")
+						byes[m.HomeParticipant] = true
+						continue
+					}
+
+					require.False(t, seen[m.AwayParticipant], "participant plays twice in a round")
+					seen[m.AwayParticipant] = true
+					key := pairingOf(m.HomeParticipant, m.AwayParticipant)
+					require.False(t, pairings[key], "participants are paired more than once")
+					pairings[key] = true
+				}
+				require.Len(t, seen, n)
+
+				previous = append(previous, playSwissRound(t, matches, func(m models.EventMatch) bson.ObjectID {
+					return m.HomeParticipant
+				})...)
+			}
+		})
+	}
+
+	t.Run("ByeGoesToLowestRanked", func(t *testing.T) {
+		participants := generateParticipants(t, event, 5)
+		first := swissRoundMatchSet(event, participants, nil, 1)
+		previous := playSwissRound(t, first, func(m models.EventMatch) bson.ObjectID {
+			return m.HomeParticipant
+		})
+
+		standings := computeStandings(participants, previous)
+		var lowest bson.ObjectID
+		for i := len(standings) - 1; i >= 0; i-- {
+			if standings[i].Wins == 0 {
+				lowest = standings[i].Participant
+				break
+			}
+		}
+
+		second := swissRoundMatchSet(event, participants, previous, 2)
+		bye := second[len(second)-1]
+		assert.Equal(t, models.ParticipantFieldReferencesBye, bye.AwayRef)
+		assert.Equal(t, lowest, bye.HomeParticipant)
+	})
+
+	t.Run("PairsEqualRecords", func(t *testing.T) {
+		participants := generateParticipants(t, event, 8)
+		first := swissRoundMatchSet(event, participants, nil, 1)
+		previous := playSwissRound(t, first, func(m models.EventMatch) bson.ObjectID {
+			return m.HomeParticipant
+		})
+
+		winners := make(map[bson.ObjectID]bool)
+		for _, m := range previous {
+			winners[m.Winner] = true
+		}
+
+		for _, m := range swissRoundMatchSet(event, participants, previous, 2) {
+			assert.Equal(t, winners[m.HomeParticipant], winners[m.AwayParticipant], "participants with different records are paired")
+		}
+	})
+}
@@ -14,8 +14,11 @@ package core
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
-	"math/bits"
+	"math"
+	"math/rand/v2"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/tournabyte/webapi/pkg/dbx"
@@ -42,7 +45,35 @@ const (
 	matchListRecordKey         = "matchListRecords"
 	matchLookupRequest         = "matchLookupRequest"
 	matchDeclareWinnerRequest  = "matchWinnerDeclaredRequest"
+	matchSetCreationRequest    = "createMatchSetRequest"
+	matchScoreSubmitRequest    = "submitMatchScoreRequest"
+	eventStandingsKey          = "eventStandings"
 	matchIDResponseKey         = "matchIDResponse"
+	matchRevisionResponseKey   = "matchRevisionResponse"
+	eventStatusTransitionKey   = "eventStatusTransition"
+	eventRemovalRequest        = "removeEventRequest"
+	eventListRequest           = "listEventsRequest"
+	eventListResponseKey       = "eventListResponse"
+	eventRemovalResponseKey    = "eventRemovalResponse"
+)
+
+// Errors specific to match result workflow tasks
+var (
+	ErrMatchAlreadyDecided           = errors.New("match result has already been declared (revise it instead)")
+	ErrMatchResultNotApplied         = errors.New("match was decided concurrently or the declared winner does not play in it")
+	ErrMatchParticipantsUndetermined = errors.New("match participants have not been determined")
+	ErrMatchDrawNotAllowed           = errors.New("match cannot end in a draw")
+	ErrEvenSeriesLength              = errors.New("series must be played over an odd number of games")
+	ErrSeriesTooLong                 = errors.New("more games recorded than the series allows")
+	ErrGamesAfterSeriesDecided       = errors.New("games recorded after the series was decided")
+)
+
+// Errors specific to swiss round workflow tasks
+var (
+	ErrSwissRoundClosed          = errors.New("event status disallowed pairing another round")
+	ErrSwissParticipantCount     = errors.New("unsupported number of participants for competition")
+	ErrNotSwissMatchSet          = errors.New("event match set was not generated in the swiss format")
+	ErrSwissPreviousRoundPending = errors.New("previous round has matches without a result")
 )
 
 // Function `(*tournabyteAPIService).initEventCreationWorkspace` initializes the handler workspace for an event creation request handling sequence
@@ -82,6 +113,42 @@ func (srv *tournabyteAPIService) initEventLookupWorkspace(ctx *gin.Context) *han
 	return &space
 }
 
+// Function `(*tournabyteAPIService).initEventListWorkspace` initializes the handler workspace for an event listing request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for listing events
+func (srv *tournabyteAPIService) initEventListWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveHeaders|handlerutil.ShouldHaveQueryParameters)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
+// Function `(*tournabyteAPIService).initEventRemovalWorkspace` initializes the handler workspace for an event removal request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for removing an event
+func (srv *tournabyteAPIService) initEventRemovalWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders|handlerutil.ShouldHaveQueryParameters)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
 // Function `(*tournabyteAPIService).initEventUpdateWorkspace` initializes the handler workspace for an event update request handling sequence
 //
 // Parameters:
@@ -154,6 +221,24 @@ func (srv *tournabyteAPIService) initParticipantUpdateWorkspace(ctx *gin.Context
 	return &space
 }
 
+// Function `(*tournabyteAPIService).initMatchSetCreationWorkspace` initializes the handler workspace for a match set creation request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for generating the matches of an event
+func (srv *tournabyteAPIService) initMatchSetCreationWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders|handlerutil.ShouldHaveJSONBody)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
 // Function `(*tournabyteAPIService).initMatchLookupWorkspace` initializes the handler workspace for an match lookup request handling sequence
 //
 // Parameters:
@@ -206,10 +291,34 @@ func eventCreationPipeline(ctx context.Context) (context.Context, context.Cancel
 
 	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
-	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventCreationRequestFromBody, out2)
-	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveEventRecordFromRequest, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, createEventRecord, out4)
-	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out5)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireVerifiedEmail, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireAPIKeyScope(models.APIKeyScopeManage), out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventCreationRequestFromBody, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveEventRecordFromRequest, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, createEventRecord, out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `eventListPipeline` initializes a handling pipeline for event listing
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func eventListPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventListRequestFromQuery, out2)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordPageFromDatabase, out3)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
@@ -254,7 +363,7 @@ func eventModificiationPipeline(ctx context.Context) (context.Context, context.C
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageEvent), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventModificationRequestFromBody, out5)
 	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyEventRecordModificationByID, out6)
 	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out7)
@@ -280,9 +389,90 @@ func eventDeletionPipeline(ctx context.Context) (context.Context, context.Cancel
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
-	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeEventRecordByID, out5)
-	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out6)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permDeleteEvent), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventRemovalRequestFromQuery, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeEventDependentRecords, out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, removeEventRecordByID, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `startEventPipeline` initializes a handling pipeline for moving an event from planned to in-progress
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func startEventPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageEvent), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchSetFromDatabaseByEventID, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventStartable, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyEventStatusTransition, out7)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out8)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `concludeEventPipeline` initializes a handling pipeline for moving an event from in-progress to concluded
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func concludeEventPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageEvent), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchSetFromDatabaseByEventID, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventConcludable, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyEventStatusTransition, out7)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out8)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `cancelEventPipeline` initializes a handling pipeline for cancelling an event that has not concluded
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func cancelEventPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageEvent), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventCancellable, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyEventStatusTransition, out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventIDResponse, out7)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
@@ -305,11 +495,12 @@ func createParticipantPipeline(ctx context.Context) (context.Context, context.Ca
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageParticipants), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindNewParticipantRequestFromBody, out5)
-	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveParticipantRecordFromRequest, out6)
-	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventModifiable, out7)
-	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createParticipantRecord, out8)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, resolveParticipantUser, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveParticipantRecordFromRequest, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventModifiable, out8)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createParticipantRecord, out9)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
@@ -376,10 +567,11 @@ func updateParticipantPipeline(ctx context.Context) (context.Context, context.Ca
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindParticipantLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageParticipants), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindNewParticipantRequestFromBody, out5)
-	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventModifiable, out6)
-	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, updateParticipantRecord, out7)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, resolveParticipantUser, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventModifiable, out7)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, updateParticipantRecord, out8)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
@@ -402,14 +594,14 @@ func removeParticipantPipeline(ctx context.Context) (context.Context, context.Ca
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindParticipantLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageParticipants), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventModifiable, out5)
 	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, removeParticipantRecord, out6)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
 
-// Function `createMatchSetPipeline` initializes a handling pipeline for creating a single-elimination match set for the given event ID
+// Function `createMatchSetPipeline` initializes a handling pipeline for creating the match set (in the requested format) for the given event ID
 //
 // Parameters:
 //   - ctx: the parent context to control the created pipeline
@@ -427,11 +619,13 @@ func createMatchSetPipeline(ctx context.Context) (context.Context, context.Cance
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageMatches), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventModifiable, out5)
-	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchParticipantsFromDatabaseByEventID, out6)
-	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveMatchSetFromParticipantList, out7)
-	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createMatchSetRecord, out8)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchSetCreationRequestFromBody, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchParticipantsFromDatabaseByEventID, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveMatchSetFromParticipantList, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, recordEventDrawSeed, out9)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createMatchSetRecord, out10)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
@@ -499,7 +693,7 @@ func tryResolveAwayParticipantPipeline(ctx context.Context) (context.Context, co
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageMatches), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchFromDatabaseByID, out5)
 	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, updateAwayParticipantIfAvailable, out6)
 
@@ -524,7 +718,7 @@ func tryResolveHomeParticipantPipeline(ctx context.Context) (context.Context, co
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageMatches), out4)
 	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchFromDatabaseByID, out5)
 	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, updateHomeParticipantIfAvailable, out6)
 
@@ -549,9 +743,121 @@ func declareMatchWinnerPipeline(ctx context.Context) (context.Context, context.C
 	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
 	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchLookupRequestFromURI, out2)
 	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
-	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventOwnership, out4)
-	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchWinnerDeclarationRequestFromBody, out5)
-	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, updateMatchWinnerByID, out6)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permScoreMatches), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventInProgress, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchWinnerDeclarationRequestFromBody, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchFromDatabaseByID, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, updateMatchWinnerByID, out8)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, advanceMatchResult, out9)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `reviseMatchResultPipeline` initializes a handling pipeline for replacing the declared result of a match (rolling back every match that consumed it)
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func reviseMatchResultPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageMatches), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventNotConcluded, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchWinnerDeclarationRequestFromBody, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchFromDatabaseByID, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, rollbackMatchResult, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, updateMatchWinnerByID, out9)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, advanceMatchResult, out10)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `submitMatchScorePipeline` initializes a handling pipeline for recording the score of a match and deriving its result once a side clinches
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func submitMatchScorePipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permScoreMatches), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyEventInProgress, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMatchScoreSubmissionRequestFromBody, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchFromDatabaseByID, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveMatchResultFromScore, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, updateMatchScoreByID, out9)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, advanceMatchResult, out10)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `createSwissRoundPipeline` initializes a handling pipeline for pairing the next swiss round of the given event ID
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func createSwissRoundPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageMatches), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchParticipantsFromDatabaseByEventID, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchSetFromDatabaseByEventID, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveNextSwissRound, out7)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createMatchSetRecord, out8)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `getStandingsPipeline` initializes a handling pipeline for calculating the standings of an event
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func getStandingsPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchParticipantsFromDatabaseByEventID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMatchSetFromDatabaseByEventID, out4)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveStandingsFromMatchSet, out5)
 
 	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
 }
@@ -614,6 +920,72 @@ func bindEventLookupRequestFromURI(ctx context.Context, space *handlerutil.Handl
 	return nil
 }
 
+// Function `bindEventListRequestFromQuery` binds the request query parameters to the event listing request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindEventListRequestFromQuery(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var query models.ListEventsRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request query parameters to variable of type %T...", query)
+	if err := bindings.BindQueryParameters(&query); err != nil {
+		log.Printf("[HANDLER]: error binding request query parameters (%s)", err.Error())
+		return err
+	}
+
+	if query.Limit == 0 {
+		query.Limit = models.DefaultListLimit
+	}
+
+	space.Set(eventListRequest, query)
+	log.Printf("[HANDLER]: saved request query parameters as variable of type %T within workspace under key %q", query, eventListRequest)
+	return nil
+}
+
+// Function `bindEventRemovalRequestFromQuery` binds the request query parameters to the event removal request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindEventRemovalRequestFromQuery(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var query models.RemoveEventRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request query parameters to variable of type %T...", query)
+	if err := bindings.BindQueryParameters(&query); err != nil {
+		log.Printf("[HANDLER]: error binding request query parameters (%s)", err.Error())
+		return err
+	}
+
+	if query.Mode == "" {
+		query.Mode = models.RemovalModeDelete
+	}
+
+	space.Set(eventRemovalRequest, query)
+	log.Printf("[HANDLER]: saved request query parameters as variable of type %T within workspace under key %q", query, eventRemovalRequest)
+	return nil
+}
+
 // Function `bindParticipantLookupRequestFromURI` binds the request URI values to the participant lookup request format (and validates it)
 //
 // Parameters:
@@ -769,7 +1141,7 @@ func bindMatchWinnerDeclarationRequestFromBody(ctx context.Context, space *handl
 	return nil
 }
 
-// Function `verifyEventOwnership` checks that the owner of the event record is the same as presented in the access token
+// Function `bindMatchScoreSubmissionRequestFromBody` binds the request body to the match score submission request format (and validates it)
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
@@ -777,37 +1149,57 @@ func bindMatchWinnerDeclarationRequestFromBody(ctx context.Context, space *handl
 //
 // Returns:
 //   - `error`: error that occurred during this processing step
-func verifyEventOwnership(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
-	var whoami string
-	var userid bson.ObjectID
-	var record models.EventRecord
-	var err error
+func bindMatchScoreSubmissionRequestFromBody(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.SubmitMatchScoreRequest
+	var bindings handlerutil.Bindings
 
-	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
-	if err = space.Get(activeUserID, &whoami); err != nil {
-		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
-	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
-		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+	log.Printf("[HANDLER]: binding request body to variable of type %T...", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", eventRecordKey, record)
-	if err = space.Get(eventRecordKey, &record); err != nil {
-		log.Printf("[HANDLER]: error loading event record data (%s)", err.Error())
+	space.Set(matchScoreSubmitRequest, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, matchScoreSubmitRequest)
+	return nil
+}
+
+// Function `bindMatchSetCreationRequestFromBody` binds the request body to the match set creation request format (and validates it)
+//
+// The body is optional; a request without one generates a single-elimination bracket with the default options.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindMatchSetCreationRequestFromBody(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.CreateMatchSetRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
 		return err
 	}
 
-	log.Print("[HANDLER]: comparing token user ID to user ID associated with record...")
-	if userid != record.Host {
-		log.Print("[HANDLER]: ownership cannot be verified, rejecting update request")
-		return errors.New("cannot update event that is not owned by you")
+	log.Printf("[HANDLER]: binding request body to variable of type %T...", body)
+	if err := bindings.BindBodyAsJSON(&body); errors.Is(err, io.EOF) {
+		log.Printf("[HANDLER]: request body is empty, generating a single-elimination bracket")
+	} else if err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
 	}
 
-	log.Print("[HANDLER]: ownership verified, proceeding with update")
+	space.Set(matchSetCreationRequest, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, matchSetCreationRequest)
 	return nil
 }
 
@@ -839,6 +1231,173 @@ func verifyEventModifiable(ctx context.Context, space *handlerutil.HandlerWorksp
 	return nil
 }
 
+// Function `verifyEventNotConcluded` checks that the results of an event can still be changed (status is neither "CONCLUDED" nor "CANCELLED")
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func verifyEventNotConcluded(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading request data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking if the event record status field is 'CONCLUDED' or 'CANCELLED'...")
+	if event.Status == models.StatusConcluded || event.Status == models.StatusCancelled {
+		log.Printf("[HANDLER]: event status field is %q; the event results are final", event.Status)
+		return statusTransitionError{from: event.Status, to: event.Status, reason: "results of a finished event cannot change"}
+	}
+
+	log.Printf("[HANDLER]: event status allows for result modification")
+	return nil
+}
+
+// Function `verifyEventInProgress` checks that results can be entered for the event (status is "IN-PROGRESS")
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func verifyEventInProgress(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading request data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking if the event record status field is 'IN-PROGRESS'...")
+	if event.Status != models.StatusInProgress {
+		log.Printf("[HANDLER]: event status field is %q; results cannot be entered", event.Status)
+		return statusTransitionError{from: event.Status, to: event.Status, reason: "results can only be entered while the event is in progress"}
+	}
+
+	log.Printf("[HANDLER]: event status allows for entering results")
+	return nil
+}
+
+// Function `verifyEventStartable` checks that a planned event has a match set and can move to in-progress
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func verifyEventStartable(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var matchList []models.EventMatch = make([]models.EventMatch, 0)
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading match set from workspace under %q into variable of type %T...", matchListRecordKey, matchList)
+	if err = space.Get(matchListRecordKey, &matchList); err != nil {
+		log.Printf("[HANDLER]: error loading match set (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking transition from %q to %q...", event.Status, models.StatusInProgress)
+	if err = checkStatusTransition(event.Status, models.StatusInProgress); err != nil {
+		log.Printf("[HANDLER]: %s", err.Error())
+		return err
+	}
+
+	if len(matchList) == 0 {
+		log.Printf("[HANDLER]: event (_id=%q) has no match set", event.ID.Hex())
+		return statusTransitionError{from: event.Status, to: models.StatusInProgress, reason: "no match set has been created"}
+	}
+
+	log.Printf("[HANDLER]: saved requested status to workspace under the %q key", eventStatusTransitionKey)
+	space.Set(eventStatusTransitionKey, models.StatusInProgress)
+	return nil
+}
+
+// Function `verifyEventConcludable` checks that an in-progress event has a final result and can move to concluded
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func verifyEventConcludable(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var matchList []models.EventMatch = make([]models.EventMatch, 0)
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading match set from workspace under %q into variable of type %T...", matchListRecordKey, matchList)
+	if err = space.Get(matchListRecordKey, &matchList); err != nil {
+		log.Printf("[HANDLER]: error loading match set (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking transition from %q to %q...", event.Status, models.StatusConcluded)
+	if err = checkStatusTransition(event.Status, models.StatusConcluded); err != nil {
+		log.Printf("[HANDLER]: %s", err.Error())
+		return err
+	}
+
+	if !matchSetConcluded(matchList) {
+		log.Printf("[HANDLER]: event (_id=%q) has matches deciding its outcome without a result", event.ID.Hex())
+		return statusTransitionError{from: event.Status, to: models.StatusConcluded, reason: "the final match has no winner"}
+	}
+
+	log.Printf("[HANDLER]: saved requested status to workspace under the %q key", eventStatusTransitionKey)
+	space.Set(eventStatusTransitionKey, models.StatusConcluded)
+	return nil
+}
+
+// Function `verifyEventCancellable` checks that an event has not finished and can move to cancelled
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func verifyEventCancellable(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking transition from %q to %q...", event.Status, models.StatusCancelled)
+	if err = checkStatusTransition(event.Status, models.StatusCancelled); err != nil {
+		log.Printf("[HANDLER]: %s", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: saved requested status to workspace under the %q key", eventStatusTransitionKey)
+	space.Set(eventStatusTransitionKey, models.StatusCancelled)
+	return nil
+}
+
 // Function `deriveEventRecordFromRequest` uses the request body within the workspace to initialize an event record
 //
 // Parameters:
@@ -879,6 +1438,8 @@ func deriveEventRecordFromRequest(ctx context.Context, space *handlerutil.Handle
 	record.Name = req.Name
 	record.Game = req.Game
 	record.Description = req.Description
+	record.OpenRegistration = req.OpenRegistration
+	record.Metadata = dbx.InitialMetadata()
 
 	log.Printf("[HANDLER]: saved event record to workspace under the %q key", eventRecordKey)
 	space.Set(eventRecordKey, record)
@@ -914,6 +1475,14 @@ func deriveParticipantRecordFromRequest(ctx context.Context, space *handlerutil.
 	log.Printf("[HANDLER]: initializing participant record...")
 	participant.ID = bson.NewObjectID()
 	participant.DisplayName = req.DisplayName
+	participant.Seed = req.Seed
+	if req.User != "" {
+		if participant.User, err = bson.ObjectIDFromHex(req.User); err != nil {
+			log.Printf("[HANDLER]: could not interpret referenced user ID as an ObjectID (%s)", err.Error())
+			return err
+		}
+	}
+	participant.Metadata = dbx.InitialMetadata()
 	participant.ParticipatesIn = event.ID
 
 	log.Printf("[HANDLER]: saved participant record to workspace under the %q key", participantRecordKey)
@@ -931,10 +1500,11 @@ func deriveParticipantRecordFromRequest(ctx context.Context, space *handlerutil.
 //   - `error`: error that occurred during this processing step
 func deriveMatchSetFromParticipantList(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
 	var event models.EventRecord
+	var req models.CreateMatchSetRequest
 	var participantList []models.EventParticipant = make([]models.EventParticipant, 0)
 	var matchList []models.EventMatch = make([]models.EventMatch, 0)
 	var participantCount uint
-	var matchCount uint
+	var drawSeed int64
 	var err error
 
 	log.Printf("[HANDLER]: loading participant list from workspace under %q into variable of type %T...", participantListRecordsKey, participantList)
@@ -949,6 +1519,12 @@ func deriveMatchSetFromParticipantList(ctx context.Context, space *handlerutil.H
 		return err
 	}
 
+	log.Printf("[HANDLER]: loading match set request from workspace under %q into variable of type %T...", matchSetCreationRequest, req)
+	if err = space.Get(matchSetCreationRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading match set request (%s)", err.Error())
+		return err
+	}
+
 	log.Print("[HANDLER]: validating that there are enough participants for a bracket...")
 	participantCount = uint(len(participantList))
 	if participantCount < 2 {
@@ -961,86 +1537,60 @@ func deriveMatchSetFromParticipantList(ctx context.Context, space *handlerutil.H
 		log.Printf("[HANDLER]: too many participants for a bracket (%d)", participantCount)
 		return errors.New("too many participants for competition")
 	}
-	matchCount = (1 << bits.Len(participantCount-1)) - 1
-	log.Printf("[HANDLER]: creating matchset for %d participants (%d matches needed)", participantCount, matchCount)
-
-	log.Print("[HANDLER]: populating match list with unlinked match records...")
-	for i := 0; i < int(matchCount); i++ {
-		matchList = append(matchList, models.EventMatch{
-			ID:               bson.NewObjectID(),
-			TakesPlaceDuring: event.ID,
-		})
-	}
 
-	log.Print("[HANDLER]: relating matches as a binary heap...")
-	for i := 0; i < int(matchCount); i++ {
-		awayIdx := 2*i + 1
-		if awayIdx >= 0 && awayIdx < int(matchCount) {
-			matchList[i].AwayParticipant = matchList[awayIdx].ID
-			matchList[i].AwayRef = models.ParticipantFieldReferencesMatch
-		}
-
-		homeIdx := 2*i + 2
-		if homeIdx >= 0 && homeIdx < int(matchCount) {
-			matchList[i].HomeParticipant = matchList[homeIdx].ID
-			matchList[i].HomeRef = models.ParticipantFieldReferencesMatch
+	if req.Seeding == models.SeedingRandom {
+		drawSeed = req.RandomSeed
+		if drawSeed == 0 {
+			drawSeed = rand.Int64N(math.MaxInt64) + 1
 		}
+		log.Printf("[HANDLER]: shuffling unseeded participants with draw seed %d", drawSeed)
+		event.DrawSeed = drawSeed
+		space.Set(eventRecordKey, event)
 	}
 
-	log.Print("[HANDLER]: seeding first round matches...")
-	home := 0
-	away := int(matchCount)
-	for i := int(matchCount) / 2; i < int(matchCount); i++ {
-		if home >= 0 && home < len(participantList) {
-			matchList[i].HomeParticipant = participantList[home].ID
-			matchList[i].HomeRef = models.ParticipantFieldReferencesPlayer
-		} else {
-			matchList[i].HomeParticipant = bson.NilObjectID
-			matchList[i].HomeRef = models.ParticipantFieldReferencesBye
-		}
-
-		if away >= 0 && away < len(participantList) {
-			matchList[i].AwayParticipant = participantList[away].ID
-			matchList[i].AwayRef = models.ParticipantFieldReferencesPlayer
-		} else {
-			matchList[i].AwayParticipant = bson.NilObjectID
-			matchList[i].AwayRef = models.ParticipantFieldReferencesBye
-		}
+	log.Print("[HANDLER]: validating the series length of the matches...")
+	if req.BestOf%2 == 0 && req.BestOf != 0 {
+		log.Printf("[HANDLER]: matches cannot be played as a best-of-%d", req.BestOf)
+		return ErrEvenSeriesLength
+	}
 
-		home++
-		away--
+	log.Print("[HANDLER]: ordering participants by seed...")
+	if participantList, err = seededParticipantOrder(participantList, drawSeed); err != nil {
+		log.Printf("[HANDLER]: error ordering participants by seed (%s)", err.Error())
+		return err
 	}
 
-	log.Print("[HANDLER]: propogating BYE matches...")
-	for i := int(matchCount) - 1; i >= 0; i-- {
-		if matchList[i].AwayParticipant == bson.NilObjectID && matchList[i].HomeParticipant != bson.NilObjectID {
-			matchList[i].Winner = matchList[i].HomeParticipant
-		}
-		if matchList[i].AwayParticipant != bson.NilObjectID && matchList[i].HomeParticipant == bson.NilObjectID {
-			matchList[i].Winner = matchList[i].AwayParticipant
-		}
-		if matchList[i].HomeRef == models.ParticipantFieldReferencesMatch {
-			feederIdx := 2*i + 2
-			if feederIdx >= 0 && feederIdx < int(matchCount) && matchList[feederIdx].Winner != bson.NilObjectID {
-				matchList[i].HomeParticipant = matchList[feederIdx].Winner
-				matchList[i].HomeRef = models.ParticipantFieldReferencesPlayer
-			}
+	switch req.Format {
+	case models.FormatRoundRobin:
+		groups := max(req.Groups, 1)
+		if uint(groups*2) > participantCount {
+			log.Printf("[HANDLER]: too many groups (%d) for %d participants", groups, participantCount)
+			return errors.New("every group needs at least two participants")
 		}
-		if matchList[i].AwayRef == models.ParticipantFieldReferencesMatch {
-			feederIdx := 2*i + 1
-			if feederIdx >= 0 && feederIdx < int(matchCount) && matchList[feederIdx].Winner != bson.NilObjectID {
-				matchList[i].AwayParticipant = matchList[feederIdx].Winner
-				matchList[i].AwayRef = models.ParticipantFieldReferencesPlayer
-			}
-		}
-	}
+		log.Printf("[HANDLER]: creating round-robin matchset for %d participants across %d groups", participantCount, groups)
+		matchList = roundRobinMatchSet(event.ID, participantList, groups)
+	case models.FormatSwiss:
+		log.Printf("[HANDLER]: pairing the first swiss round for %d participants", participantCount)
+		matchList = swissRoundMatchSet(event.ID, participantList, nil, 1)
+	case models.FormatDoubleElimination:
+		log.Printf("[HANDLER]: creating double-elimination matchset for %d participants (bracket reset: %t)", participantCount, req.BracketReset)
+		matchList = doubleEliminationMatchSet(event.ID, participantList, req.BracketReset)
+	default:
+		log.Printf("[HANDLER]: creating single-elimination matchset for %d participants", participantCount)
+		matchList = singleEliminationMatchSet(event.ID, participantList)
+	}
+	for i := range matchList {
+		matchList[i].BestOf = req.BestOf
+		matchList[i].Metadata = dbx.InitialMetadata()
+	}
+	log.Printf("[HANDLER]: created %d match records", len(matchList))
 
 	log.Print("[HANDLER]: match set initialized")
 	space.Set(matchListRecordKey, matchList)
 	return nil
 }
 
-// Function `createEventRecord` inserts the event record within the workspace into the database
+// Function `deriveMatchResultFromScore` records the submitted game scores on the match within the workspace and derives the winner once a side clinches the series
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
@@ -1048,91 +1598,142 @@ func deriveMatchSetFromParticipantList(ctx context.Context, space *handlerutil.H
 //
 // Returns:
 //   - `error`: error that occurred during this processing step
-func createEventRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
-	var sess *mongo.Session
-	var event models.EventRecord
-	var cfg *options.InsertOneOptionsBuilder
+func deriveMatchResultFromScore(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.SubmitMatchScoreRequest
+	var match models.EventMatch
+	var homeWins, awayWins int
 	var err error
 
-	log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", eventRecordKey, event)
-	if err = space.Get(eventRecordKey, &event); err != nil {
-		log.Printf("[HANDLER]: error loading event record data (%s)", err.Error())
+	log.Printf("[HANDLER]: loading score submission from workspace under %q key into variable of type %T...", matchScoreSubmitRequest, req)
+	if err = space.Get(matchScoreSubmitRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading score submission (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading database operation settings...")
-	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
-		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+	log.Printf("[HANDLER]: loading match record from workspace under %q key into variable of type %T...", matchRecordKey, match)
+	if err = space.Get(matchRecordKey, &match); err != nil {
+		log.Printf("[HANDLER]: error loading match record (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading database session from request context...")
-	if sess, err = dbx.MongoFromContext(ctx); err != nil {
-		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
-		return err
+	log.Printf("[HANDLER]: checking that match (_id=%q) is ready to be scored...", match.ID.Hex())
+	if match.HomeRef != models.ParticipantFieldReferencesPlayer || match.AwayRef != models.ParticipantFieldReferencesPlayer {
+		log.Printf("[HANDLER]: match participants are not known yet (home_ref=%q; away_ref=%q)", match.HomeRef, match.AwayRef)
+		return ErrMatchParticipantsUndetermined
+	}
+	if match.Winner != bson.NilObjectID || match.Draw {
+		log.Printf("[HANDLER]: match already has a declared result")
+		return ErrMatchAlreadyDecided
 	}
 
-	log.Printf("[HANDLER]: performing database insertion operation...")
-	_, err = sess.Client().
-		Database(models.EventQueryContext.Database).
-		Collection(models.EventQueryContext.Collection).
-		InsertOne(ctx, event, cfg)
+	if req.BestOf != 0 {
+		match.BestOf = req.BestOf
+	}
+	match.Games = req.Games
 
-	if err != nil {
-		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+	log.Printf("[HANDLER]: tallying %d games of a best-of-%d series...", len(match.Games), max(match.BestOf, 1))
+	if homeWins, awayWins, err = seriesScore(match.BestOf, match.Games); err != nil {
+		log.Printf("[HANDLER]: error tallying the series (%s)", err.Error())
+		return err
 	}
 
-	return err
+	needed := max(match.BestOf, 1)/2 + 1
+	switch {
+	case homeWins >= needed:
+		log.Printf("[HANDLER]: home participant clinched the series %d-%d", homeWins, awayWins)
+		match.Winner, match.Loser = match.HomeParticipant, match.AwayParticipant
+	case awayWins >= needed:
+		log.Printf("[HANDLER]: away participant clinched the series %d-%d", awayWins, homeWins)
+		match.Winner, match.Loser = match.AwayParticipant, match.HomeParticipant
+	case len(match.Games) == max(match.BestOf, 1):
+		if match.Bracket != models.BracketRoundRobin && match.Bracket != models.BracketSwiss {
+			log.Printf("[HANDLER]: series ended %d-%d but the match must produce a winner", homeWins, awayWins)
+			return ErrMatchDrawNotAllowed
+		}
+		log.Printf("[HANDLER]: series ended in a %d-%d draw", homeWins, awayWins)
+		match.Draw = true
+	default:
+		log.Printf("[HANDLER]: series in progress (%d-%d)", homeWins, awayWins)
+	}
+
+	log.Printf("[HANDLER]: saved scored match record to workspace under the %q key", matchRecordKey)
+	space.Set(matchRecordKey, match)
+	return nil
 }
 
-// Function `createParticipantRecord` inserts the participant record within the workspace into the database
+// Function `deriveNextSwissRound` uses the participant list and previous rounds within the workspace to pair the next swiss round of an event
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
 //   - space: the workspace to utilize
 //
 // Returns:
-//   - `error`: error that occurred during this processing step
-func createParticipantRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
-	var sess *mongo.Session
-	var player models.EventParticipant
-	var cfg *options.InsertOneOptionsBuilder
+//   - `error`: `ErrSwissRoundClosed`, `ErrSwissParticipantCount`, `ErrNotSwissMatchSet` or `ErrSwissPreviousRoundPending` if the event cannot pair
+//     another round yet (or another error that occurred during this processing step)
+func deriveNextSwissRound(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var participantList []models.EventParticipant = make([]models.EventParticipant, 0)
+	var previous []models.EventMatch = make([]models.EventMatch, 0)
+	var matchList []models.EventMatch
+	var round int
 	var err error
 
-	log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", participantRecordKey, player)
-	if err = space.Get(participantRecordKey, &player); err != nil {
-		log.Printf("[HANDLER]: error loading participant record data (%s)", err.Error())
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading database operation settings...")
-	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
-		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+	log.Printf("[HANDLER]: loading participant list from workspace under %q into variable of type %T...", participantListRecordsKey, participantList)
+	if err = space.Get(participantListRecordsKey, &participantList); err != nil {
+		log.Printf("[HANDLER]: error loading participant list (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading database session from request context...")
-	if sess, err = dbx.MongoFromContext(ctx); err != nil {
-		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+	log.Printf("[HANDLER]: loading previous rounds from workspace under %q into variable of type %T...", matchListRecordKey, previous)
+	if err = space.Get(matchListRecordKey, &previous); err != nil {
+		log.Printf("[HANDLER]: error loading previous rounds (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: performing database insertion operation...")
-	_, err = sess.Client().
-		Database(models.ParticipantQueryContext.Database).
-		Collection(models.ParticipantQueryContext.Collection).
-		InsertOne(ctx, player, cfg)
+	log.Printf("[HANDLER]: checking if the event record status field allows new rounds...")
+	if event.Status == models.StatusConcluded || event.Status == models.StatusCancelled {
+		log.Printf("[HANDLER]: event is %q; no further rounds can be paired", event.Status)
+		return ErrSwissRoundClosed
+	}
 
-	if err != nil {
-		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+	log.Print("[HANDLER]: validating the number of participants for a swiss round...")
+	if len(participantList) < 2 || len(participantList) > 256 {
+		log.Printf("[HANDLER]: unsupported number of participants for a swiss round (%d)", len(participantList))
+		return ErrSwissParticipantCount
 	}
 
-	space.Set(participatIDResponseKey, models.ParticipantID{EID: player.ParticipatesIn.Hex(), PID: player.ID.Hex()})
-	return err
+	log.Printf("[HANDLER]: checking that the %d previous matches are decided swiss matches...", len(previous))
+	for _, m := range previous {
+		if m.Bracket != models.BracketSwiss {
+			log.Printf("[HANDLER]: match (_id=%q) was not paired as part of a swiss round", m.ID.Hex())
+			return ErrNotSwissMatchSet
+		}
+		if !isDecided(m) && !isAwardedBye(m) {
+			log.Printf("[HANDLER]: match (_id=%q) of round %d has no result yet", m.ID.Hex(), m.Round)
+			return ErrSwissPreviousRoundPending
+		}
+		round = max(round, m.Round)
+	}
+	round++
+
+	log.Printf("[HANDLER]: pairing round %d for %d participants...", round, len(participantList))
+	matchList = swissRoundMatchSet(event.ID, participantList, previous, round)
+	for i := range matchList {
+		matchList[i].Metadata = dbx.InitialMetadata()
+	}
 
+	log.Printf("[HANDLER]: saved %d match records to workspace under the %q key", len(matchList), matchListRecordKey)
+	space.Set(matchListRecordKey, matchList)
+	return nil
 }
 
-// Function `createMatchSetRecord` creates the matches associated with an event
+// Function `deriveStandingsFromMatchSet` uses the participant list and match set within the workspace to calculate the event standings
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
@@ -1140,8 +1741,134 @@ func createParticipantRecord(ctx context.Context, space *handlerutil.HandlerWork
 //
 // Returns:
 //   - `error`: error that occurred during this processing step
-func createMatchSetRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
-	var sess *mongo.Session
+func deriveStandingsFromMatchSet(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var participantList []models.EventParticipant = make([]models.EventParticipant, 0)
+	var matchList []models.EventMatch = make([]models.EventMatch, 0)
+	var standings []models.EventStanding
+	var err error
+
+	log.Printf("[HANDLER]: loading participant list from workspace under %q into variable of type %T...", participantListRecordsKey, participantList)
+	if err = space.Get(participantListRecordsKey, &participantList); err != nil {
+		log.Printf("[HANDLER]: error loading participant list (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading match list from workspace under %q into variable of type %T...", matchListRecordKey, matchList)
+	if err = space.Get(matchListRecordKey, &matchList); err != nil {
+		log.Printf("[HANDLER]: error loading match list (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: tallying results of %d matches for %d participants...", len(matchList), len(participantList))
+	standings = computeStandings(participantList, matchList)
+
+	log.Printf("[HANDLER]: saved standings to workspace under the %q key", eventStandingsKey)
+	space.Set(eventStandingsKey, standings)
+	return nil
+}
+
+// Function `createEventRecord` inserts the event record within the workspace into the database
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createEventRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var sess *mongo.Session
+	var event models.EventRecord
+	var cfg *options.InsertOneOptionsBuilder
+	var err error
+
+	log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation...")
+	_, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		InsertOne(ctx, event, cfg)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+	}
+
+	return err
+}
+
+// Function `createParticipantRecord` inserts the participant record within the workspace into the database
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createParticipantRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var sess *mongo.Session
+	var player models.EventParticipant
+	var cfg *options.InsertOneOptionsBuilder
+	var err error
+
+	log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", participantRecordKey, player)
+	if err = space.Get(participantRecordKey, &player); err != nil {
+		log.Printf("[HANDLER]: error loading participant record data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation...")
+	_, err = sess.Client().
+		Database(models.ParticipantQueryContext.Database).
+		Collection(models.ParticipantQueryContext.Collection).
+		InsertOne(ctx, player, cfg)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+	}
+
+	space.Set(participatIDResponseKey, models.ParticipantID{EID: player.ParticipatesIn.Hex(), PID: player.ID.Hex()})
+	return err
+
+}
+
+// Function `createMatchSetRecord` creates the matches associated with an event
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createMatchSetRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var sess *mongo.Session
 	var matches []models.EventMatch = make([]models.EventMatch, 0)
 	var cfg *options.InsertManyOptionsBuilder
 	var err error
@@ -1220,7 +1947,7 @@ func fetchEventRecordFromDatabaseByID(ctx context.Context, space *handlerutil.Ha
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "_id", Value: id}}
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	err = sess.Client().
 		Database(models.EventQueryContext.Database).
 		Collection(models.EventQueryContext.Collection).
@@ -1237,6 +1964,94 @@ func fetchEventRecordFromDatabaseByID(ctx context.Context, space *handlerutil.Ha
 
 }
 
+// Function `fetchEventRecordPageFromDatabase` fetches the page of event records matching the listing request within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchEventRecordPageFromDatabase(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var sess *mongo.Session
+	var cur *mongo.Cursor
+	var req models.ListEventsRequest
+	var page models.EventListResponse
+	var filter bson.D
+	var after *pageCursor
+	var cfg *options.FindOptionsBuilder
+	var err error
+
+	log.Printf("[HANDLER]: loading event listing request from workspace under %q key into variable of type %T...", eventListRequest, req)
+	if err := space.Get(eventListRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading listing request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting page cursor presented in listing request...")
+	if after, err = decodePageCursor(req.Cursor); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided page cursor (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: building event listing filter...")
+	if filter, err = eventListFilter(req); err != nil {
+		log.Printf("[HANDLER]: could not build listing filter (%s)", err.Error())
+		return err
+	}
+
+	if after != nil {
+		log.Printf("[HANDLER]: resuming listing after event (_id=%q)...", after.ID.Hex())
+		resume, err := keysetCondition(eventListOrdering(req.Sort), *after)
+		if err != nil {
+			log.Printf("[HANDLER]: page cursor does not match the listing ordering (%s)", err.Error())
+			return err
+		}
+		filter = append(filter, bson.E{Key: "$and", Value: bson.A{resume}})
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(
+		dbx.FindCap(req.Limit+1),
+		dbx.FindSortKey(eventListOrdering(req.Sort)...),
+	); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	cur, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		Find(ctx, filter, cfg)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	page.Events = make([]models.EventRecord, 0)
+	if err = cur.All(ctx, &page.Events); err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	if int64(len(page.Events)) > req.Limit {
+		page.Events = page.Events[:req.Limit]
+		page.Next = encodePageCursor(eventListPosition(req.Sort, page.Events[len(page.Events)-1]))
+	}
+
+	log.Printf("[HANDLER]: found %d events (more=%t)", len(page.Events), page.Next != "")
+	space.Set(eventListResponseKey, page)
+	return nil
+}
+
 // Function `applyEventRecordModificationByID` updates the event record within the workspace into the database
 //
 // Parameters:
@@ -1278,28 +2093,407 @@ func applyEventRecordModificationByID(ctx context.Context, space *handlerutil.Ha
 		return err
 	}
 
-	if req.NewName != "" {
-		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "name", Value: req.NewName}}})
-	}
-	if req.NewGame != "" {
-		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "game", Value: req.NewGame}}})
-	}
-	if req.NewDescription != "" {
-		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "description", Value: req.NewDescription}}})
-	}
-	if req.NewStatus != "" {
-		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "status", Value: req.NewStatus}}})
-	}
-	log.Printf("[HANDLER]: configured update: %v", update)
-
-	log.Print("[HANDLER]: running database update operation...")
+	if req.NewName != "" {
+		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "name", Value: req.NewName}}})
+	}
+	if req.NewGame != "" {
+		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "game", Value: req.NewGame}}})
+	}
+	if req.NewDescription != "" {
+		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "description", Value: req.NewDescription}}})
+	}
+	if req.OpenRegistration != nil {
+		update = append(update, bson.E{Key: "$set", Value: bson.D{{Key: "open_registration", Value: *req.OpenRegistration}}})
+	}
+	log.Printf("[HANDLER]: configured update: %v", update)
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateByID(
+			ctx,
+			which.ID,
+			update,
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.ModifiedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (%d)", res.ModifiedCount)
+		return errors.New("update not properly applied")
+	}
+
+	log.Printf("[HANDLER]: update applied to event (_id=%q)", which.ID.Hex())
+	return nil
+}
+
+// Function `recordEventDrawSeed` stores the random number generator seed used to order participants on the event record so the draw can be audited
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func recordEventDrawSeed(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.CreateMatchSetRequest
+	var event models.EventRecord
+	var cfg *options.UpdateOneOptionsBuilder
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading match set request from workspace under %q into variable of type %T...", matchSetCreationRequest, req)
+	if err = space.Get(matchSetCreationRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading match set request (%s)", err.Error())
+		return err
+	}
+
+	if req.Seeding != models.SeedingRandom {
+		log.Print("[HANDLER]: participants were seeded manually, no draw seed to record")
+		return nil
+	}
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateByID(
+			ctx,
+			event.ID,
+			bson.D{{Key: "$set", Value: bson.D{{Key: "draw_seed", Value: event.DrawSeed}}}},
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents matched (%d)", res.MatchedCount)
+		return errors.New("update not properly applied")
+	}
+
+	log.Printf("[HANDLER]: recorded draw seed %d for event (_id=%q)", event.DrawSeed, event.ID.Hex())
+	return nil
+}
+
+// Function `applyEventStatusTransition` moves the event record within the workspace to the status requested by the previous verification stage
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func applyEventStatusTransition(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var status string
+	var cfg *options.UpdateOneOptionsBuilder
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading requested status from workspace under %q key into variable of type %T...", eventStatusTransitionKey, status)
+	if err = space.Get(eventStatusTransitionKey, &status); err != nil {
+		log.Printf("[HANDLER]: error loading requested status (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: event.ID}, {Key: "status", Value: event.Status}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "status", Value: status}}}},
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.ModifiedCount != 1 {
+		log.Printf("[HANDLER]: event status changed while the transition was processed (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return statusTransitionError{from: event.Status, to: status, reason: "event status changed concurrently"}
+	}
+
+	log.Printf("[HANDLER]: event (_id=%q) moved from %q to %q", event.ID.Hex(), event.Status, status)
+	event.Status = status
+	space.Set(eventRecordKey, event)
+	return nil
+}
+
+// Function `updateParticipantRecord` updates the specific participants record within the workspace into the database
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func updateParticipantRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var modify models.CreateOrModifyParticipantRequest
+	var cfg *options.UpdateOneOptionsBuilder
+	var err error
+	var which models.ParticipantID
+	var playerID bson.ObjectID
+	var eventID bson.ObjectID
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+
+	log.Printf("[HANDLER]: loading event update request from workspace under %q key into variable of type %T...", participantCreationRequest, modify)
+	if err := space.Get(participantCreationRequest, &modify); err != nil {
+		log.Printf("[HANDLER]: error loading update request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", participantLookupRequest, which)
+	if err := space.Get(participantLookupRequest, &which); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
+	if playerID, err = bson.ObjectIDFromHex(which.PID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
+	if eventID, err = bson.ObjectIDFromHex(which.EID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	set := bson.D{{Key: "display_name", Value: modify.DisplayName}}
+	unset := bson.D{}
+	if modify.Seed == 0 {
+		unset = append(unset, bson.E{Key: "seed", Value: ""})
+	} else {
+		set = append(set, bson.E{Key: "seed", Value: modify.Seed})
+	}
+	if modify.User == "" {
+		unset = append(unset, bson.E{Key: "user", Value: ""})
+	} else if user, err := bson.ObjectIDFromHex(modify.User); err != nil {
+		log.Printf("[HANDLER]: could not interpret referenced user ID as an ObjectID (%s)", err.Error())
+		return err
+	} else {
+		set = append(set, bson.E{Key: "user", Value: user})
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	log.Printf("[HANDLER]: configured update: %v", update)
+
+	log.Printf("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.ParticipantQueryContext.Database).
+		Collection(models.ParticipantQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: playerID}, {Key: "participates_in", Value: eventID}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}},
+			update,
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.ModifiedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return errors.New("update not properly applied")
+	}
+
+	space.Set(participatIDResponseKey, which)
+	log.Printf("[HANDLER]: update applied to event (_id=%q)", which.EID)
+	return nil
+}
+
+// Function `updateMatchWinnerByID` sets the winner field (or the draw flag) for a given match
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func updateMatchWinnerByID(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var modify models.DeclarMatchWinnerRequest
+	var which models.MatchID
+	var match models.EventMatch
+	var winner bson.ObjectID
+	var loser bson.ObjectID
+	var matchID bson.ObjectID
+	var eventID bson.ObjectID
+	var cfg *options.UpdateOneOptionsBuilder
+	var err error
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+
+	log.Printf("[HANDLER]: loading match update request from workspace under %q key into variable of type %T...", matchDeclareWinnerRequest, modify)
+	if err := space.Get(matchDeclareWinnerRequest, &modify); err != nil {
+		log.Printf("[HANDLER]: error loading update request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading match lookup request from workspace under %q key into variable of type %T...", matchLookupRequest, which)
+	if err := space.Get(matchLookupRequest, &which); err != nil {
+		log.Printf("[HANDLER]: error loading lookup request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
+	if matchID, err = bson.ObjectIDFromHex(which.MID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
+	if eventID, err = bson.ObjectIDFromHex(which.EID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading match record from workspace under %q key into variable of type %T...", matchRecordKey, match)
+	if err := space.Get(matchRecordKey, &match); err != nil {
+		log.Printf("[HANDLER]: error loading match record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking that match (_id=%q) has no declared result...", match.ID.Hex())
+	if match.Winner != bson.NilObjectID || match.Draw {
+		log.Printf("[HANDLER]: match already has a declared result")
+		return ErrMatchAlreadyDecided
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: matchID},
+		{Key: "takes_place_during", Value: eventID},
+		{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}},
+		{Key: "winner", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "draw", Value: bson.D{{Key: "$ne", Value: true}}},
+	}
+	update := bson.D{}
+
+	if modify.DeclareDraw {
+		log.Printf("[HANDLER]: checking that the match (bracket=%q) can end in a draw...", match.Bracket)
+		if match.Bracket != models.BracketRoundRobin && match.Bracket != models.BracketSwiss {
+			log.Printf("[HANDLER]: matches outside of round-robin and swiss play must produce a winner")
+			return ErrMatchDrawNotAllowed
+		}
+		filter = append(
+			filter,
+			bson.E{Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer},
+			bson.E{Key: "away_ref", Value: models.ParticipantFieldReferencesPlayer},
+		)
+		update = append(update, bson.E{Key: "draw", Value: true})
+	} else {
+		log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
+		if winner, err = bson.ObjectIDFromHex(modify.DeclareWinner); err != nil {
+			log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+			return err
+		}
+
+		log.Printf("[HANDLER]: determining the participant eliminated by the declared winner...")
+		if winner == match.HomeParticipant && match.AwayRef == models.ParticipantFieldReferencesPlayer {
+			loser = match.AwayParticipant
+		}
+		if winner == match.AwayParticipant && match.HomeRef == models.ParticipantFieldReferencesPlayer {
+			loser = match.HomeParticipant
+		}
+
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "home", Value: winner}, {Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer}},
+			bson.D{{Key: "away", Value: winner}, {Key: "away_ref", Value: models.ParticipantFieldReferencesPlayer}},
+		}})
+		update = append(update, bson.E{Key: "winner", Value: winner})
+		if loser != bson.NilObjectID {
+			update = append(update, bson.E{Key: "loser", Value: loser})
+		}
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: running database update operation...")
 	res, err = sess.Client().
-		Database(models.EventQueryContext.Database).
-		Collection(models.EventQueryContext.Collection).
-		UpdateByID(
+		Database(models.MatchQueryContext.Database).
+		Collection(models.MatchQueryContext.Collection).
+		UpdateOne(
 			ctx,
-			which.ID,
-			update,
+			filter,
+			bson.D{{Key: "$set", Value: update}},
 			cfg,
 		)
 
@@ -1308,16 +2502,26 @@ func applyEventRecordModificationByID(ctx context.Context, space *handlerutil.Ha
 		return err
 	}
 
+	if res.MatchedCount == 0 {
+		log.Printf("[HANDLER]: no undecided match (_id=%s) with the declared winner found", matchID.Hex())
+		return ErrMatchResultNotApplied
+	}
+
 	if res.ModifiedCount != 1 {
-		log.Printf("[HANDLER]: incorrect number of documents updated (%d)", res.ModifiedCount)
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
 		return errors.New("update not properly applied")
 	}
 
-	log.Printf("[HANDLER]: update applied to event (_id=%q)", which.ID.Hex())
+	log.Printf("[HANDLER]: declared result for match (_id=%s)", matchID.Hex())
+	match.Winner = winner
+	match.Loser = loser
+	match.Draw = modify.DeclareDraw
+	space.Set(matchRecordKey, match)
+	space.Set(matchIDResponseKey, which)
 	return nil
 }
 
-// Function `updateParticipantRecord` updates the specific participants record within the workspace into the database
+// Function `updateMatchScoreByID` stores the game scores (and the derived result, if any) of the match within the workspace into the database
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
@@ -1325,32 +2529,39 @@ func applyEventRecordModificationByID(ctx context.Context, space *handlerutil.Ha
 //
 // Returns:
 //   - `error`: error that occurred during this processing step
-func updateParticipantRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
-	var modify models.CreateOrModifyParticipantRequest
+func updateMatchScoreByID(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var match models.EventMatch
 	var cfg *options.UpdateOneOptionsBuilder
 	var err error
-	var which models.ParticipantID
-	var playerID bson.ObjectID
 	var sess *mongo.Session
 	var res *mongo.UpdateResult
 
-	log.Printf("[HANDLER]: loading event update request from workspace under %q key into variable of type %T...", participantCreationRequest, modify)
-	if err := space.Get(participantCreationRequest, &modify); err != nil {
-		log.Printf("[HANDLER]: error loading update request (%s)", err.Error())
+	log.Printf("[HANDLER]: loading match record from workspace under %q key into variable of type %T...", matchRecordKey, match)
+	if err := space.Get(matchRecordKey, &match); err != nil {
+		log.Printf("[HANDLER]: error loading match record (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", participantLookupRequest, which)
-	if err := space.Get(participantLookupRequest, &which); err != nil {
-		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
-		return err
+	filter := bson.D{
+		{Key: "_id", Value: match.ID},
+		{Key: "takes_place_during", Value: match.TakesPlaceDuring},
+		{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}},
+		{Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer},
+		{Key: "away_ref", Value: models.ParticipantFieldReferencesPlayer},
+		{Key: "winner", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "draw", Value: bson.D{{Key: "$ne", Value: true}}},
 	}
-
-	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
-	if playerID, err = bson.ObjectIDFromHex(which.PID); err != nil {
-		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
-		return err
+	update := bson.D{
+		{Key: "games", Value: match.Games},
+		{Key: "best_of", Value: match.BestOf},
+	}
+	if match.Winner != bson.NilObjectID {
+		update = append(update, bson.E{Key: "winner", Value: match.Winner}, bson.E{Key: "loser", Value: match.Loser})
 	}
+	if match.Draw {
+		update = append(update, bson.E{Key: "draw", Value: true})
+	}
+	log.Printf("[HANDLER]: configured update: %v", update)
 
 	log.Printf("[HANDLER]: loading database operation settings...")
 	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
@@ -1366,12 +2577,12 @@ func updateParticipantRecord(ctx context.Context, space *handlerutil.HandlerWork
 
 	log.Printf("[HANDLER]: running database update operation...")
 	res, err = sess.Client().
-		Database(models.ParticipantQueryContext.Database).
-		Collection(models.ParticipantQueryContext.Collection).
-		UpdateByID(
+		Database(models.MatchQueryContext.Database).
+		Collection(models.MatchQueryContext.Collection).
+		UpdateOne(
 			ctx,
-			playerID,
-			bson.D{{Key: "$set", Value: bson.D{{Key: "display_name", Value: modify.DisplayName}}}},
+			filter,
+			bson.D{{Key: "$set", Value: update}},
 			cfg,
 		)
 
@@ -1380,17 +2591,18 @@ func updateParticipantRecord(ctx context.Context, space *handlerutil.HandlerWork
 		return err
 	}
 
-	if res.ModifiedCount != 1 {
-		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents matched (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
 		return errors.New("update not properly applied")
 	}
 
-	space.Set(participatIDResponseKey, which)
-	log.Printf("[HANDLER]: update applied to event (_id=%q)", which.EID)
+	log.Printf("[HANDLER]: recorded score for match (_id=%s)", match.ID.Hex())
 	return nil
 }
 
-// Function `updateMatchWinnerByID` sets the winner field for a given match
+// Function `rollbackMatchResult` clears the declared result of the match within the workspace and resets every match that consumed it
+//
+// Matches are reset transitively: a match whose participant is reset loses its own result, so the matches it fed are reset as well.
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
@@ -1398,89 +2610,247 @@ func updateParticipantRecord(ctx context.Context, space *handlerutil.HandlerWork
 //
 // Returns:
 //   - `error`: error that occurred during this processing step
-func updateMatchWinnerByID(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
-	var modify models.DeclarMatchWinnerRequest
-	var which models.MatchID
-	var winner bson.ObjectID
-	var matchID bson.ObjectID
-	var eventID bson.ObjectID
-	var cfg *options.UpdateOneOptionsBuilder
-	var err error
+func rollbackMatchResult(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
 	var sess *mongo.Session
+	var match models.EventMatch
+	var invalidated []string = make([]string, 0)
 	var res *mongo.UpdateResult
+	var err error
 
-	log.Printf("[HANDLER]: loading match update request from workspace under %q key into variable of type %T...", matchDeclareWinnerRequest, modify)
-	if err := space.Get(matchDeclareWinnerRequest, &modify); err != nil {
-		log.Printf("[HANDLER]: error loading update request (%s)", err.Error())
+	log.Printf("[HANDLER]: loading match record from workspace under %q key into variable of type %T...", matchRecordKey, match)
+	if err := space.Get(matchRecordKey, &match); err != nil {
+		log.Printf("[HANDLER]: error loading match record (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading match lookup request from workspace under %q key into variable of type %T...", matchLookupRequest, which)
-	if err := space.Get(matchLookupRequest, &which); err != nil {
-		log.Printf("[HANDLER]: error loading lookup request (%s)", err.Error())
-		return err
+	log.Printf("[HANDLER]: checking that match (_id=%q) has a result to revise...", match.ID.Hex())
+	if match.HomeRef == models.ParticipantFieldReferencesBye || match.AwayRef == models.ParticipantFieldReferencesBye {
+		log.Printf("[HANDLER]: match was decided by a bye")
+		return errors.New("match decided by a bye cannot be revised")
+	}
+	if match.Winner == bson.NilObjectID && !match.Draw {
+		log.Printf("[HANDLER]: match has no declared result")
+		return errors.New("match has no result to revise")
 	}
 
-	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
-	if matchID, err = bson.ObjectIDFromHex(which.MID); err != nil {
-		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
-	if eventID, err = bson.ObjectIDFromHex(which.EID); err != nil {
-		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
-		return err
+	cleared := bson.D{{Key: "winner", Value: ""}, {Key: "loser", Value: ""}, {Key: "draw", Value: ""}, {Key: "games", Value: ""}}
+
+	pending := []models.EventMatch{match}
+	for len(pending) > 0 {
+		decided := pending[0]
+		pending = pending[1:]
+
+		var cur *mongo.Cursor
+		var consumers []models.EventMatch = make([]models.EventMatch, 0)
+
+		log.Printf("[HANDLER]: performing database lookup operation (matches sourced from match %q)", decided.ID.Hex())
+		filter := bson.D{
+			{Key: "takes_place_during", Value: decided.TakesPlaceDuring},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "home_source", Value: decided.ID}, {Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer}},
+				bson.D{{Key: "away_source", Value: decided.ID}, {Key: "away_ref", Value: models.ParticipantFieldReferencesPlayer}},
+			}},
+		}
+		cur, err = sess.Client().
+			Database(models.MatchQueryContext.Database).
+			Collection(models.MatchQueryContext.Collection).
+			Find(ctx, filter)
+
+		if err != nil {
+			log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+			return err
+		}
+
+		if err = cur.All(ctx, &consumers); err != nil {
+			log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+			return err
+		}
+
+		for _, consumer := range consumers {
+			var reset bson.D
+
+			if consumer.HomeSource == decided.ID && consumer.HomeRef == models.ParticipantFieldReferencesPlayer {
+				reset = append(reset, bson.E{Key: "home", Value: decided.ID}, bson.E{Key: "home_ref", Value: consumer.HomeSourceRef})
+			}
+			if consumer.AwaySource == decided.ID && consumer.AwayRef == models.ParticipantFieldReferencesPlayer {
+				reset = append(reset, bson.E{Key: "away", Value: decided.ID}, bson.E{Key: "away_ref", Value: consumer.AwaySourceRef})
+			}
+
+			log.Printf("[HANDLER]: performing database update operation (resetting match %q)", consumer.ID.Hex())
+			res, err = sess.Client().
+				Database(models.MatchQueryContext.Database).
+				Collection(models.MatchQueryContext.Collection).
+				UpdateByID(ctx, consumer.ID, bson.D{{Key: "$set", Value: reset}, {Key: "$unset", Value: cleared}})
+
+			if err != nil {
+				log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+				return err
+			}
+
+			if res.ModifiedCount != 1 {
+				log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+				return errors.New("update not properly applied")
+			}
+
+			invalidated = append(invalidated, consumer.ID.Hex())
+			if consumer.Winner != bson.NilObjectID || consumer.Draw {
+				pending = append(pending, consumer)
+			}
+		}
 	}
 
-	log.Printf("[HANDLER]: interpreting ID presented in lookup request as an ObjectID...")
-	if winner, err = bson.ObjectIDFromHex(modify.DeclareWinner); err != nil {
-		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+	log.Printf("[HANDLER]: performing database update operation (clearing result of match %q)", match.ID.Hex())
+	res, err = sess.Client().
+		Database(models.MatchQueryContext.Database).
+		Collection(models.MatchQueryContext.Collection).
+		UpdateByID(ctx, match.ID, bson.D{{Key: "$unset", Value: cleared}})
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: loading database operation settings...")
-	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
-		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+	if res.ModifiedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return errors.New("update not properly applied")
+	}
+
+	log.Printf("[HANDLER]: rolled back result of match (_id=%q) and %d downstream matches", match.ID.Hex(), len(invalidated))
+	match.Winner, match.Loser, match.Draw, match.Games = bson.NilObjectID, bson.NilObjectID, false, nil
+	space.Set(matchRecordKey, match)
+	space.Set(matchRevisionResponseKey, models.MatchRevisionResponse{Match: match.ID.Hex(), Invalidated: invalidated})
+	return nil
+}
+
+// Function `advanceMatchResult` moves the winner and the loser of the match within the workspace into the matches referencing them
+//
+// A referencing match left with a participant facing a bye is awarded to that participant straight away and its winner is advanced in turn, so the bracket
+// never waits on a match that cannot be played.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func advanceMatchResult(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var sess *mongo.Session
+	var match models.EventMatch
+	var err error
+
+	log.Printf("[HANDLER]: loading match record from workspace under %q key into variable of type %T...", matchRecordKey, match)
+	if err := space.Get(matchRecordKey, &match); err != nil {
+		log.Printf("[HANDLER]: error loading match record (%s)", err.Error())
 		return err
 	}
 
+	if match.Winner == bson.NilObjectID {
+		log.Printf("[HANDLER]: match (_id=%q) has no winner, nothing to advance", match.ID.Hex())
+		return nil
+	}
+
 	log.Printf("[HANDLER]: loading database session from request context...")
 	if sess, err = dbx.MongoFromContext(ctx); err != nil {
 		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: running database update operation...")
-	res, err = sess.Client().
-		Database(models.MatchQueryContext.Database).
-		Collection(models.MatchQueryContext.Collection).
-		UpdateOne(
-			ctx,
-			bson.D{
-				{Key: "_id", Value: matchID},
-				{Key: "takes_place_during", Value: eventID},
+	pending := []models.EventMatch{match}
+	for len(pending) > 0 {
+		decided := pending[0]
+		pending = pending[1:]
+
+		outcomes := []matchSlot{{id: decided.Winner, ref: models.ParticipantFieldReferencesMatch}}
+		if decided.Loser != bson.NilObjectID {
+			outcomes = append(outcomes, matchSlot{id: decided.Loser, ref: models.ParticipantFieldReferencesLoser})
+		}
+
+		for _, outcome := range outcomes {
+			var destination models.EventMatch
+			var update bson.D
+			var res *mongo.UpdateResult
+
+			log.Printf("[HANDLER]: performing database lookup operation (match referencing %q of match %q)", outcome.ref, decided.ID.Hex())
+			filter := bson.D{
+				{Key: "takes_place_during", Value: decided.TakesPlaceDuring},
 				{Key: "$or", Value: bson.A{
-					bson.D{{Key: "home", Value: winner}, {Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer}},
-					bson.D{{Key: "away", Value: winner}, {Key: "away_ref", Value: models.ParticipantFieldReferencesPlayer}},
+					bson.D{{Key: "home", Value: decided.ID}, {Key: "home_ref", Value: outcome.ref}},
+					bson.D{{Key: "away", Value: decided.ID}, {Key: "away_ref", Value: outcome.ref}},
 				}},
-			},
-			bson.D{{Key: "$set", Value: bson.D{{Key: "winner", Value: winner}}}},
-			cfg,
-		)
+			}
+			err = sess.Client().
+				Database(models.MatchQueryContext.Database).
+				Collection(models.MatchQueryContext.Collection).
+				FindOne(ctx, filter).
+				Decode(&destination)
+
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				log.Printf("[HANDLER]: no match references the %q of match (_id=%q)", outcome.ref, decided.ID.Hex())
+				continue
+			}
+			if err != nil {
+				log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+				return err
+			}
 
-	if err != nil {
-		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
-		return err
-	}
+			if destination.HomeParticipant == decided.ID && destination.HomeRef == outcome.ref {
+				destination.HomeParticipant, destination.HomeRef = outcome.id, models.ParticipantFieldReferencesPlayer
+				update = append(update, bson.E{Key: "home", Value: outcome.id}, bson.E{Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer})
+			} else {
+				destination.AwayParticipant, destination.AwayRef = outcome.id, models.ParticipantFieldReferencesPlayer
+				update = append(update, bson.E{Key: "away", Value: outcome.id}, bson.E{Key: "away_ref", Value: models.ParticipantFieldReferencesPlayer})
+			}
 
-	if res.ModifiedCount != 1 {
-		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
-		return errors.New("update not properly applied")
+			switch {
+			case destination.Bracket == models.BracketGrandFinalReset && outcome.ref == models.ParticipantFieldReferencesLoser && decided.Winner == decided.HomeParticipant:
+				log.Printf("[HANDLER]: winners bracket champion won the grand final, settling the reset match without playing it")
+				destination.HomeParticipant, destination.HomeRef = decided.Winner, models.ParticipantFieldReferencesPlayer
+				destination.Winner, destination.Loser = decided.Winner, decided.Loser
+				update = append(
+					update,
+					bson.E{Key: "home", Value: decided.Winner},
+					bson.E{Key: "home_ref", Value: models.ParticipantFieldReferencesPlayer},
+					bson.E{Key: "winner", Value: decided.Winner},
+					bson.E{Key: "loser", Value: decided.Loser},
+				)
+			case destination.HomeRef == models.ParticipantFieldReferencesPlayer && destination.AwayRef == models.ParticipantFieldReferencesBye:
+				log.Printf("[HANDLER]: home participant of match (_id=%q) is facing a bye, awarding the match", destination.ID.Hex())
+				destination.Winner = destination.HomeParticipant
+				update = append(update, bson.E{Key: "winner", Value: destination.Winner})
+				pending = append(pending, destination)
+			case destination.AwayRef == models.ParticipantFieldReferencesPlayer && destination.HomeRef == models.ParticipantFieldReferencesBye:
+				log.Printf("[HANDLER]: away participant of match (_id=%q) is facing a bye, awarding the match", destination.ID.Hex())
+				destination.Winner = destination.AwayParticipant
+				update = append(update, bson.E{Key: "winner", Value: destination.Winner})
+				pending = append(pending, destination)
+			}
+
+			log.Printf("[HANDLER]: performing database update operation (match referencing %q of match %q)", outcome.ref, decided.ID.Hex())
+			res, err = sess.Client().
+				Database(models.MatchQueryContext.Database).
+				Collection(models.MatchQueryContext.Collection).
+				UpdateByID(ctx, destination.ID, bson.D{{Key: "$set", Value: update}})
+
+			if err != nil {
+				log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+				return err
+			}
+
+			if res.ModifiedCount != 1 {
+				log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+				return errors.New("update not properly applied")
+			}
+
+			log.Printf("[HANDLER]: advanced %q of match (_id=%q) into match (_id=%q)", outcome.ref, decided.ID.Hex(), destination.ID.Hex())
+		}
 	}
 
-	log.Printf("[HANDLER]: declared winner for match (_id=%s)", matchID.Hex())
-	space.Set(matchIDResponseKey, which)
 	return nil
 }
 
@@ -1530,7 +2900,7 @@ func removeParticipantRecord(ctx context.Context, space *handlerutil.HandlerWork
 		Collection(models.ParticipantQueryContext.Collection).
 		DeleteOne(
 			ctx,
-			bson.D{{Key: "_id", Value: playerID}, {Key: "participates_in", Value: eventID}},
+			bson.D{{Key: "_id", Value: playerID}, {Key: "participates_in", Value: eventID}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}},
 		)
 
 	if err != nil {
@@ -1582,7 +2952,7 @@ func fetchParticipantsFromDatabaseByEventID(ctx context.Context, space *handleru
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "participates_in", Value: id}}
+	filter := bson.D{{Key: "participates_in", Value: id}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	cur, err = sess.Client().
 		Database(models.ParticipantQueryContext.Database).
 		Collection(models.ParticipantQueryContext.Collection).
@@ -1644,7 +3014,7 @@ func fetchParticipantFromDatabaseByPlayerID(ctx context.Context, space *handleru
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "_id", Value: playerID}, {Key: "participates_in", Value: eventID}}
+	filter := bson.D{{Key: "_id", Value: playerID}, {Key: "participates_in", Value: eventID}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	err = sess.Client().
 		Database(models.ParticipantQueryContext.Database).
 		Collection(models.ParticipantQueryContext.Collection).
@@ -1697,7 +3067,7 @@ func fetchMatchSetFromDatabaseByEventID(ctx context.Context, space *handlerutil.
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "takes_place_during", Value: id}}
+	filter := bson.D{{Key: "takes_place_during", Value: id}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	cur, err = sess.Client().
 		Database(models.MatchQueryContext.Database).
 		Collection(models.MatchQueryContext.Collection).
@@ -1759,7 +3129,7 @@ func fetchMatchFromDatabaseByID(ctx context.Context, space *handlerutil.HandlerW
 	}
 
 	log.Printf("[HANDLER]: performing database lookup operation")
-	filter := bson.D{{Key: "_id", Value: matchID}, {Key: "takes_place_during", Value: eventID}}
+	filter := bson.D{{Key: "_id", Value: matchID}, {Key: "takes_place_during", Value: eventID}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
 	err = sess.Client().
 		Database(models.MatchQueryContext.Database).
 		Collection(models.MatchQueryContext.Collection).
@@ -1924,7 +3294,7 @@ func updateHomeParticipantIfAvailable(ctx context.Context, space *handlerutil.Ha
 	return nil
 }
 
-// Function `removeEventRecordByID` deletes the event participants record within the workspace into the database
+// Function `removeEventDependentRecords` deletes (or archives) the participant and match records referencing the event record within the workspace
 //
 // Parameters:
 //   - ctx: the context managing the lifecycle of this handler
@@ -1932,10 +3302,11 @@ func updateHomeParticipantIfAvailable(ctx context.Context, space *handlerutil.Ha
 //
 // Returns:
 //   - `error`: error that occurred during this processing step
-func removeEventRecordByID(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+func removeEventDependentRecords(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
 	var which models.EventRecord
+	var req models.RemoveEventRequest
+	var response models.EventRemovalResponse
 	var sess *mongo.Session
-	var res *mongo.DeleteResult
 	var err error
 
 	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, which)
@@ -1944,31 +3315,134 @@ func removeEventRecordByID(ctx context.Context, space *handlerutil.HandlerWorksp
 		return err
 	}
 
+	log.Printf("[HANDLER]: loading removal request from workspace under %q key into variable of type %T...", eventRemovalRequest, req)
+	if err := space.Get(eventRemovalRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading removal request (%s)", err.Error())
+		return err
+	}
+
 	log.Printf("[HANDLER]: loading database session from request context...")
 	if sess, err = dbx.MongoFromContext(ctx); err != nil {
 		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
 		return err
 	}
 
-	log.Printf("[HANDLER]: running database delete...")
-	res, err = sess.Client().
-		Database(models.EventQueryContext.Database).
-		Collection(models.EventQueryContext.Collection).
-		DeleteOne(ctx, bson.D{{Key: "_id", Value: which.ID}})
+	dependents := []struct {
+		collection dbx.QueryContext
+		field      string
+		count      *int64
+	}{
+		{models.ParticipantQueryContext, "participates_in", &response.Participants},
+		{models.MatchQueryContext, "takes_place_during", &response.Matches},
+	}
 
-	if err != nil {
-		log.Printf("[HANDLER]: error during database delete operation (%s)", err.Error())
+	for _, dependent := range dependents {
+		filter := bson.D{{Key: dependent.field, Value: which.ID}}
+		coll := sess.Client().Database(dependent.collection.Database).Collection(dependent.collection.Collection)
+
+		if req.Mode == models.RemovalModeArchive {
+			var res *mongo.UpdateResult
+
+			log.Printf("[HANDLER]: running database update (archiving %s)...", dependent.collection.Collection)
+			res, err = coll.UpdateMany(
+				ctx,
+				append(filter, bson.E{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}),
+				bson.D{{Key: "$set", Value: bson.D{{Key: "metadata.active", Value: false}, {Key: "metadata.updated_at", Value: time.Now().UTC()}}}},
+			)
+			if err != nil {
+				log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+				return err
+			}
+			*dependent.count = res.ModifiedCount
+		} else {
+			var res *mongo.DeleteResult
+
+			log.Printf("[HANDLER]: running database delete (removing %s)...", dependent.collection.Collection)
+			res, err = coll.DeleteMany(ctx, filter)
+			if err != nil {
+				log.Printf("[HANDLER]: error during database delete operation (%s)", err.Error())
+				return err
+			}
+			*dependent.count = res.DeletedCount
+		}
+
+		log.Printf("[HANDLER]: %s %d %s referencing event (_id=%q)", req.Mode, *dependent.count, dependent.collection.Collection, which.ID.Hex())
+	}
+
+	response.ID = which.ID.Hex()
+	response.Mode = req.Mode
+	log.Printf("[HANDLER]: saved response data to workspace under the %q key", eventRemovalResponseKey)
+	space.Set(eventRemovalResponseKey, response)
+	return nil
+}
+
+// Function `removeEventRecordByID` deletes (or archives) the event record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func removeEventRecordByID(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var which models.EventRecord
+	var req models.RemoveEventRequest
+	var sess *mongo.Session
+	var removed int64
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, which)
+	if err := space.Get(eventRecordKey, &which); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
 		return err
 	}
 
-	if res.DeletedCount != 1 {
-		log.Printf("[HANDLER]: incorrect number of documents updated (%d)", res.DeletedCount)
+	log.Printf("[HANDLER]: loading removal request from workspace under %q key into variable of type %T...", eventRemovalRequest, req)
+	if err := space.Get(eventRemovalRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading removal request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	coll := sess.Client().Database(models.EventQueryContext.Database).Collection(models.EventQueryContext.Collection)
+	if req.Mode == models.RemovalModeArchive {
+		var res *mongo.UpdateResult
+
+		log.Printf("[HANDLER]: running database update (archiving event)...")
+		res, err = coll.UpdateByID(
+			ctx,
+			which.ID,
+			bson.D{{Key: "$set", Value: bson.D{{Key: "metadata.active", Value: false}, {Key: "metadata.updated_at", Value: time.Now().UTC()}}}},
+		)
+		if err != nil {
+			log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+			return err
+		}
+		removed = res.ModifiedCount
+	} else {
+		var res *mongo.DeleteResult
+
+		log.Printf("[HANDLER]: running database delete...")
+		res, err = coll.DeleteOne(ctx, bson.D{{Key: "_id", Value: which.ID}})
+		if err != nil {
+			log.Printf("[HANDLER]: error during database delete operation (%s)", err.Error())
+			return err
+		}
+		removed = res.DeletedCount
+	}
+
+	if removed != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents removed (%d)", removed)
 		return errors.New("delete not properly applied")
 	}
 
-	log.Printf("[HANDLER]: delete applied to event (_id=%q)", which.ID.Hex())
+	log.Printf("[HANDLER]: %s applied to event (_id=%q)", req.Mode, which.ID.Hex())
 	return nil
-
 }
 
 // Function `populateEventIDResponse` populates the fields for identifying an event by its ID
@@ -1997,3 +3471,44 @@ func populateEventIDResponse(ctx context.Context, space *handlerutil.HandlerWork
 	space.Set(eventIDResponseKey, response)
 	return nil
 }
+
+// Function `isMatchResultError` determines if the given error is a match result that cannot be declared or a series score that cannot be recorded
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isMatchResultError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrMatchAlreadyDecided), errors.Is(e, ErrMatchResultNotApplied), errors.Is(e, ErrMatchParticipantsUndetermined),
+		errors.Is(e, ErrMatchDrawNotAllowed):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("result", e.Error()),
+		), true
+	case errors.Is(e, ErrEvenSeriesLength), errors.Is(e, ErrSeriesTooLong), errors.Is(e, ErrGamesAfterSeriesDecided):
+		return handlerutil.ErrUnprocessibleEntity(
+			handlerutil.NewDetail("games", e.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `isSwissRoundError` determines if the given error is a swiss round that cannot be paired in the current state of the event
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isSwissRoundError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrSwissRoundClosed), errors.Is(e, ErrSwissParticipantCount), errors.Is(e, ErrNotSwissMatchSet), errors.Is(e, ErrSwissPreviousRoundPending):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("round", e.Error()),
+		), true
+	}
+	return nil, false
+}
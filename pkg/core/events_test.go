@@ -13,11 +13,16 @@ package core
 
 import (
 	"context"
+	"io"
 	"math/bits"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/go-jose/go-jose/v4"
 	"github.com/go-jose/go-jose/v4/jwt"
 	"github.com/go-playground/validator/v10"
@@ -49,6 +54,53 @@ var (
 			{Key: "firstBatch", Value: findEventDoc},
 		}},
 	}
+	findStartedEventOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.events"},
+			{Key: "firstBatch", Value: bson.A{
+				bson.M{
+					"_id":    findEventDoc[0].(bson.M)["_id"],
+					"host":   findEventDoc[0].(bson.M)["host"],
+					"status": models.StatusInProgress,
+					"name":   "Testing Tournament",
+					"game":   "Rock-Paper-Scissors",
+				},
+			}},
+		}},
+	}
+	listEventsDocs = bson.A{
+		bson.M{
+			"_id":    bson.NewObjectID(),
+			"host":   bson.NewObjectID(),
+			"status": models.StatusPlanned,
+			"name":   "Weekly Rock-Paper-Scissors",
+			"game":   "Rock-Paper-Scissors",
+		},
+		bson.M{
+			"_id":    bson.NewObjectID(),
+			"host":   bson.NewObjectID(),
+			"status": models.StatusInProgress,
+			"name":   "Monthly Rock-Paper-Scissors",
+			"game":   "Rock-Paper-Scissors",
+		},
+		bson.M{
+			"_id":    bson.NewObjectID(),
+			"host":   bson.NewObjectID(),
+			"status": models.StatusConcluded,
+			"name":   "Yearly Rock-Paper-Scissors",
+			"game":   "Rock-Paper-Scissors",
+		},
+	}
+	listEventsOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.events"},
+			{Key: "firstBatch", Value: listEventsDocs},
+		}},
+	}
 	findParticipantDoc = bson.A{
 		bson.M{
 			"_id":             bson.NewObjectID(),
@@ -130,6 +182,73 @@ var (
 			{Key: "firstBatch", Value: bson.A{testMatch1}},
 		}},
 	}
+	findDeclaredMatchOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.matches"},
+			{Key: "firstBatch", Value: bson.A{testMatch3}},
+		}},
+	}
+	findNoMatchOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.matches"},
+			{Key: "firstBatch", Value: bson.A{}},
+		}},
+	}
+	findWalkoverMatchOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.matches"},
+			{Key: "firstBatch", Value: bson.A{bson.M{
+				"_id":                bson.NewObjectID(),
+				"away":               bson.NilObjectID,
+				"away_ref":           models.ParticipantFieldReferencesBye,
+				"home":               testMatch3["_id"].(bson.ObjectID),
+				"home_ref":           models.ParticipantFieldReferencesMatch,
+				"takes_place_during": findEventDoc[0].(bson.M)["_id"].(bson.ObjectID),
+			}}},
+		}},
+	}
+	findDecidedMatchOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.matches"},
+			{Key: "firstBatch", Value: bson.A{bson.M{
+				"_id":                testMatch3["_id"].(bson.ObjectID),
+				"away":               testMatch3["away"].(bson.ObjectID),
+				"away_ref":           models.ParticipantFieldReferencesPlayer,
+				"home":               testMatch3["home"].(bson.ObjectID),
+				"home_ref":           models.ParticipantFieldReferencesPlayer,
+				"winner":             testMatch3["home"].(bson.ObjectID),
+				"loser":              testMatch3["away"].(bson.ObjectID),
+				"takes_place_during": findEventDoc[0].(bson.M)["_id"].(bson.ObjectID),
+			}}},
+		}},
+	}
+	findConsumingMatchesOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.matches"},
+			{Key: "firstBatch", Value: bson.A{bson.M{
+				"_id":                testMatch1["_id"].(bson.ObjectID),
+				"away":               testMatch2["_id"].(bson.ObjectID),
+				"away_ref":           models.ParticipantFieldReferencesMatch,
+				"away_source":        testMatch2["_id"].(bson.ObjectID),
+				"away_source_ref":    models.ParticipantFieldReferencesMatch,
+				"home":               testMatch3["home"].(bson.ObjectID),
+				"home_ref":           models.ParticipantFieldReferencesPlayer,
+				"home_source":        testMatch3["_id"].(bson.ObjectID),
+				"home_source_ref":    models.ParticipantFieldReferencesMatch,
+				"takes_place_during": findEventDoc[0].(bson.M)["_id"].(bson.ObjectID),
+			}}},
+		}},
+	}
 	updateOneOk = bson.D{
 		{Key: "ok", Value: 1},
 		{Key: "n", Value: 1},         // matched count
@@ -139,6 +258,15 @@ var (
 		{Key: "ok", Value: 1},
 		{Key: "n", Value: 1}, // matched count
 	}
+	deleteManyOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "n", Value: 3}, // matched count
+	}
+	updateManyOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "n", Value: 3},         // matched count
+		{Key: "nModified", Value: 3}, // modified count
+	}
 	insertBracketOk = bson.D{
 		{Key: "ok", Value: 1},
 		{Key: "n", Value: 1 << bits.Len(uint(len(listParticipantsDocs)-1))},
@@ -183,6 +311,25 @@ func setupWorkingEventLookupContext(t *testing.T) context.Context {
 	return ctx
 }
 
+func setupWorkingEventListContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		listEventsOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
 func setupWorkingEventModificationContext(t *testing.T) context.Context {
 	t.Helper()
 
@@ -208,6 +355,8 @@ func setupWorkingEventRemovalContext(t *testing.T) context.Context {
 	m := drivertest.NewMockDeployment(
 		pingResponse,
 		findEventOk,
+		deleteManyOk,
+		deleteManyOk,
 		deleteOneOk,
 	)
 	mockDb, err := dbx.NewMongoConnection(
@@ -221,6 +370,27 @@ func setupWorkingEventRemovalContext(t *testing.T) context.Context {
 	return ctx
 }
 
+func setupWorkingEventArchivalContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findEventOk,
+		updateManyOk,
+		updateManyOk,
+		updateOneOk,
+	)
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
 func setupWorkingCreateParticipantContext(t *testing.T) context.Context {
 	t.Helper()
 
@@ -335,6 +505,71 @@ func setupWorkingBracketBuilderContext(t *testing.T) context.Context {
 	return ctx
 }
 
+func setupWorkingRandomlySeededBracketBuilderContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findEventOk,
+		listParticipantOk,
+		updateOneOk,
+		insertBracketOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func setupWorkingSwissRoundContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findEventOk,
+		listParticipantOk,
+		findNoMatchOk,
+		insertBracketOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func setupWorkingEventStartContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findEventOk,
+		listMatchesOk,
+		updateOneOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
 func setupWorkingBracketFetcherContext(t *testing.T) context.Context {
 	t.Helper()
 
@@ -354,6 +589,26 @@ func setupWorkingBracketFetcherContext(t *testing.T) context.Context {
 	return ctx
 }
 
+func setupWorkingStandingsContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		listParticipantOk,
+		listMatchesOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
 func setupWorkingMatchFetcherContext(t *testing.T) context.Context {
 	t.Helper()
 
@@ -398,10 +653,86 @@ func setupWorkingMatchUpdateContext(t *testing.T) context.Context {
 func setupWorkingMatchWinnerUpdateContext(t *testing.T) context.Context {
 	t.Helper()
 
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findStartedEventOk,
+		findDeclaredMatchOk,
+		updateOneOk,
+		findMatchOk,
+		updateOneOk,
+		findNoMatchOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func setupWorkingMatchWalkoverContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findStartedEventOk,
+		findDeclaredMatchOk,
+		updateOneOk,
+		findWalkoverMatchOk,
+		updateOneOk,
+		findNoMatchOk,
+		findNoMatchOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func setupWorkingDecidedMatchContext(t *testing.T) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findStartedEventOk,
+		findDecidedMatchOk,
+	)
+
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func setupWorkingMatchRevisionContext(t *testing.T) context.Context {
+	t.Helper()
+
 	m := drivertest.NewMockDeployment(
 		pingResponse,
 		findEventOk,
+		findDecidedMatchOk,
+		findConsumingMatchesOk,
 		updateOneOk,
+		updateOneOk,
+		updateOneOk,
+		findMatchOk,
+		updateOneOk,
+		findNoMatchOk,
 	)
 
 	mockDb, err := dbx.NewMongoConnection(
@@ -436,7 +767,8 @@ func setupWorkingEventCreationWorkspace(t *testing.T) *handlerutil.HandlerWorksp
 		Expiry:    jwt.NewNumericDate(time.Now().Add(tokenOpts.ExpiresIn)),
 	}
 	cl2 := models.AuthorizationTokenClaims{
-		Me: bson.NewObjectID().Hex(),
+		Me:            bson.NewObjectID().Hex(),
+		EmailVerified: true,
 	}
 	token, err := jwt.Signed(signer).Claims(cl1).Claims(cl2).Serialize()
 	require.NoError(t, err)
@@ -553,7 +885,7 @@ func setupWorkingEventLookupWorkspace(t *testing.T) *handlerutil.HandlerWorkspac
 	return &space
 }
 
-func setupWorkingEventModificationWorkspace(t *testing.T) *handlerutil.HandlerWorkspace {
+func setupWorkingEventListWorkspace(t *testing.T, query models.ListEventsRequest) *handlerutil.HandlerWorkspace {
 	t.Helper()
 	space := handlerutil.DefaultWorkspace()
 	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(`1010101010101010101010101010101010101010101010101010101010101010`)}, nil)
@@ -574,35 +906,16 @@ func setupWorkingEventModificationWorkspace(t *testing.T) *handlerutil.HandlerWo
 		Expiry:    jwt.NewNumericDate(time.Now().Add(tokenOpts.ExpiresIn)),
 	}
 	cl2 := models.AuthorizationTokenClaims{
-		Me: findEventDoc[0].(bson.M)["host"].(bson.ObjectID).Hex(),
+		Me: bson.NewObjectID().Hex(),
 	}
 	token, err := jwt.Signed(signer).Claims(cl1).Claims(cl2).Serialize()
 	require.NoError(t, err)
 
-	req := models.EventID{
-		ID: findEventDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex(),
-	}
 	header := models.AuthorizationHeaderContent{
 		Token: token,
 	}
-	body := models.UpdateEventRequest{
-		NewStatus: "CONCLUDED",
-	}
 
 	space.Set(handlerutil.RequestBindings, handlerutil.Bindings{
-		URI: func(a any) error {
-			outVal := reflect.ValueOf(a)
-			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
-				return handlerutil.ErrNotAddressable
-			}
-
-			valVal := reflect.ValueOf(req)
-			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
-				return handlerutil.ErrNotAssignable
-			}
-			outVal.Elem().Set(valVal)
-			return nil
-		},
 		Headers: func(a any) error {
 			outVal := reflect.ValueOf(a)
 			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
@@ -616,13 +929,13 @@ func setupWorkingEventModificationWorkspace(t *testing.T) *handlerutil.HandlerWo
 			outVal.Elem().Set(valVal)
 			return nil
 		},
-		Body: func(a any) error {
+		Query: func(a any) error {
 			outVal := reflect.ValueOf(a)
 			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
 				return handlerutil.ErrNotAddressable
 			}
 
-			valVal := reflect.ValueOf(body)
+			valVal := reflect.ValueOf(query)
 			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
 				return handlerutil.ErrNotAssignable
 			}
@@ -635,10 +948,9 @@ func setupWorkingEventModificationWorkspace(t *testing.T) *handlerutil.HandlerWo
 	space.Set(models.ValidatorObjectKey, validator.New())
 
 	return &space
-
 }
 
-func setupWorkingEventRemovalWorkspace(t *testing.T) *handlerutil.HandlerWorkspace {
+func setupWorkingEventModificationWorkspace(t *testing.T) *handlerutil.HandlerWorkspace {
 	t.Helper()
 	space := handlerutil.DefaultWorkspace()
 	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(`1010101010101010101010101010101010101010101010101010101010101010`)}, nil)
@@ -670,8 +982,12 @@ func setupWorkingEventRemovalWorkspace(t *testing.T) *handlerutil.HandlerWorkspa
 	header := models.AuthorizationHeaderContent{
 		Token: token,
 	}
-	space.Set(handlerutil.RequestBindings, handlerutil.Bindings{
-		URI: func(a any) error {
+	body := models.UpdateEventRequest{
+		NewName: "Renamed Testing Tournament",
+	}
+
+	space.Set(handlerutil.RequestBindings, handlerutil.Bindings{
+		URI: func(a any) error {
 			outVal := reflect.ValueOf(a)
 			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
 				return handlerutil.ErrNotAddressable
@@ -697,6 +1013,100 @@ func setupWorkingEventRemovalWorkspace(t *testing.T) *handlerutil.HandlerWorkspa
 			outVal.Elem().Set(valVal)
 			return nil
 		},
+		Body: func(a any) error {
+			outVal := reflect.ValueOf(a)
+			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+				return handlerutil.ErrNotAddressable
+			}
+
+			valVal := reflect.ValueOf(body)
+			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+				return handlerutil.ErrNotAssignable
+			}
+			outVal.Elem().Set(valVal)
+			return nil
+		},
+	})
+
+	space.Set(authTokenOptionsKey, tokenOpts)
+	space.Set(models.ValidatorObjectKey, validator.New())
+
+	return &space
+
+}
+
+func setupWorkingEventRemovalWorkspace(t *testing.T, query models.RemoveEventRequest) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := handlerutil.DefaultWorkspace()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(`1010101010101010101010101010101010101010101010101010101010101010`)}, nil)
+	require.NoError(t, err)
+	tokenOpts := models.TokenOptions{
+		Subject:   "testsubject",
+		Issuer:    "testissuer",
+		Signer:    signer,
+		ExpiresIn: 5 * time.Minute,
+		Key:       `1010101010101010101010101010101010101010101010101010101010101010`,
+		Algorithm: "HS256",
+	}
+	cl1 := jwt.Claims{
+		Subject:   tokenOpts.Subject,
+		Issuer:    tokenOpts.Issuer,
+		IssuedAt:  jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		NotBefore: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		Expiry:    jwt.NewNumericDate(time.Now().Add(tokenOpts.ExpiresIn)),
+	}
+	cl2 := models.AuthorizationTokenClaims{
+		Me: findEventDoc[0].(bson.M)["host"].(bson.ObjectID).Hex(),
+	}
+	token, err := jwt.Signed(signer).Claims(cl1).Claims(cl2).Serialize()
+	require.NoError(t, err)
+
+	req := models.EventID{
+		ID: findEventDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex(),
+	}
+	header := models.AuthorizationHeaderContent{
+		Token: token,
+	}
+	space.Set(handlerutil.RequestBindings, handlerutil.Bindings{
+		URI: func(a any) error {
+			outVal := reflect.ValueOf(a)
+			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+				return handlerutil.ErrNotAddressable
+			}
+
+			valVal := reflect.ValueOf(req)
+			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+				return handlerutil.ErrNotAssignable
+			}
+			outVal.Elem().Set(valVal)
+			return nil
+		},
+		Headers: func(a any) error {
+			outVal := reflect.ValueOf(a)
+			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+				return handlerutil.ErrNotAddressable
+			}
+
+			valVal := reflect.ValueOf(header)
+			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+				return handlerutil.ErrNotAssignable
+			}
+			outVal.Elem().Set(valVal)
+			return nil
+		},
+		Query: func(a any) error {
+			outVal := reflect.ValueOf(a)
+			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+				return handlerutil.ErrNotAddressable
+			}
+
+			valVal := reflect.ValueOf(query)
+			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+				return handlerutil.ErrNotAssignable
+			}
+			outVal.Elem().Set(valVal)
+			return nil
+		},
 	})
 
 	space.Set(authTokenOptionsKey, tokenOpts)
@@ -1015,7 +1425,7 @@ func setupWorkingUpdateParticipantWorkspace(t *testing.T) *handlerutil.HandlerWo
 
 }
 
-func setupWorkingBracketBuilderWorkspace(t *testing.T) *handlerutil.HandlerWorkspace {
+func setupWorkingBracketBuilderWorkspace(t *testing.T, body any) *handlerutil.HandlerWorkspace {
 	t.Helper()
 	space := handlerutil.DefaultWorkspace()
 
@@ -1076,6 +1486,19 @@ func setupWorkingBracketBuilderWorkspace(t *testing.T) *handlerutil.HandlerWorks
 			outVal.Elem().Set(valVal)
 			return nil
 		},
+		Body: func(a any) error {
+			outVal := reflect.ValueOf(a)
+			if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+				return handlerutil.ErrNotAddressable
+			}
+
+			valVal := reflect.ValueOf(body)
+			if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+				return handlerutil.ErrNotAssignable
+			}
+			outVal.Elem().Set(valVal)
+			return nil
+		},
 	})
 
 	space.Set(authTokenOptionsKey, tokenOpts)
@@ -1155,7 +1578,7 @@ func setupWorkingMatchLookupWorkspace(t *testing.T) *handlerutil.HandlerWorkspac
 	return &space
 }
 
-func setupWorkingMatchUpdateWorkspace(t *testing.T) *handlerutil.HandlerWorkspace {
+func setupWorkingMatchUpdateWorkspace(t *testing.T, body any) *handlerutil.HandlerWorkspace {
 	t.Helper()
 	space := handlerutil.DefaultWorkspace()
 
@@ -1186,9 +1609,6 @@ func setupWorkingMatchUpdateWorkspace(t *testing.T) *handlerutil.HandlerWorkspac
 		EID: findEventDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex(),
 		MID: testMatch3["_id"].(bson.ObjectID).Hex(),
 	}
-	body := models.DeclarMatchWinnerRequest{
-		DeclareWinner: testMatch3["home"].(bson.ObjectID).Hex(),
-	}
 	header := models.AuthorizationHeaderContent{
 		Token: token,
 	}
@@ -1291,6 +1711,72 @@ func TestEventLookupPipeline(t *testing.T) {
 	})
 }
 
+func TestEventListPipeline(t *testing.T) {
+	t.Run("EventListFirstPage", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventListPipeline(setupWorkingEventListContext(t))
+		var result models.EventListResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingEventListWorkspace(t, models.ListEventsRequest{Game: "Rock-Paper-Scissors", Limit: 2})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventListResponseKey, &result))
+
+		assert.Len(t, result.Events, 2)
+		require.NotEmpty(t, result.Next)
+
+		position, err := decodePageCursor(result.Next)
+		require.NoError(t, err)
+		assert.Equal(t, result.Events[1].ID, position.ID)
+		assert.Equal(t, "metadata.created_at", position.Field)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("EventListLastPage", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventListPipeline(setupWorkingEventListContext(t))
+		var result models.EventListResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingEventListWorkspace(t, models.ListEventsRequest{Cursor: encodePageCursor(pageCursor{Field: "metadata.created_at", ID: bson.NewObjectID()})})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventListResponseKey, &result))
+
+		assert.Len(t, result.Events, len(listEventsDocs))
+		assert.Empty(t, result.Next)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("EventListInvalidCursor", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventListPipeline(setupWorkingEventListContext(t))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingEventListWorkspace(t, models.ListEventsRequest{Cursor: "bm90LWEtY3Vyc29y"})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		_, rejected := isInvalidCursorError(context.Cause(pCtx))
+		assert.True(t, rejected)
+	})
+}
+
 func TestEventUpdatePipeline(t *testing.T) {
 	t.Run("EventUpdatedSuccessfully", func(t *testing.T) {
 		pCtx, pCancel, pIn, pOut := eventModificiationPipeline(setupWorkingEventModificationContext(t))
@@ -1314,20 +1800,105 @@ func TestEventUpdatePipeline(t *testing.T) {
 	})
 }
 
+func TestEventStatusPipeline(t *testing.T) {
+	t.Run("EventStartedSuccessfully", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := startEventPipeline(setupWorkingEventStartContext(t))
+		var result models.EventRecord
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventRecordKey, &result))
+
+		assert.Equal(t, models.StatusInProgress, result.Status)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("EventCancelledSuccessfully", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := cancelEventPipeline(setupWorkingEventModificationContext(t))
+		var result models.EventID
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventIDResponseKey, &result))
+
+		assert.NotZero(t, result)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("EventConcludeRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := concludeEventPipeline(setupWorkingEventStartContext(t))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		_, rejected := isStatusTransitionError(context.Cause(pCtx))
+		assert.True(t, rejected)
+	})
+}
+
 func TestEventDeletePipeline(t *testing.T) {
 	t.Run("EventDeleteSuccessfully", func(t *testing.T) {
 		pCtx, pCancel, pIn, pOut := eventDeletionPipeline(setupWorkingEventRemovalContext(t))
-		var result models.EventID
+		var result models.EventRemovalResponse
 		defer close(pIn)
 		defer pCancel(nil)
 
-		pIn <- setupWorkingEventRemovalWorkspace(t)
+		pIn <- setupWorkingEventRemovalWorkspace(t, models.RemoveEventRequest{})
 
 		after, ok := <-pOut
 		require.True(t, ok, "Reading value from pipeline exit channel failed")
-		require.NoError(t, after.Get(eventIDResponseKey, &result))
+		require.NoError(t, after.Get(eventRemovalResponseKey, &result))
 
 		assert.NotZero(t, result.ID)
+		assert.Equal(t, models.RemovalModeDelete, result.Mode)
+		assert.Equal(t, int64(3), result.Participants)
+		assert.Equal(t, int64(3), result.Matches)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("EventArchiveSuccessfully", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := eventDeletionPipeline(setupWorkingEventArchivalContext(t))
+		var result models.EventRemovalResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingEventRemovalWorkspace(t, models.RemoveEventRequest{Mode: models.RemovalModeArchive})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventRemovalResponseKey, &result))
+
+		assert.Equal(t, models.RemovalModeArchive, result.Mode)
+		assert.Equal(t, int64(3), result.Participants)
+		assert.Equal(t, int64(3), result.Matches)
 
 		select {
 		case <-pCtx.Done():
@@ -1456,7 +2027,7 @@ func TestEventBracketPipeline(t *testing.T) {
 		defer close(pIn)
 		defer pCancel(nil)
 
-		pIn <- setupWorkingBracketBuilderWorkspace(t)
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{})
 
 		after, ok := <-pOut
 		require.True(t, ok, "Reading value from pipeline exit channel failed")
@@ -1471,6 +2042,75 @@ func TestEventBracketPipeline(t *testing.T) {
 		}
 	})
 
+	t.Run("CreateDoubleEliminationMatchSet", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := createMatchSetPipeline(setupWorkingBracketBuilderContext(t))
+		var result []models.EventMatch = make([]models.EventMatch, 0)
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{Format: models.FormatDoubleElimination, BracketReset: true})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchListRecordKey, &result))
+
+		assert.Equal(t, models.BracketGrandFinalReset, result[len(result)-1].Bracket)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("CreateSwissMatchSet", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := createMatchSetPipeline(setupWorkingBracketBuilderContext(t))
+		var result []models.EventMatch = make([]models.EventMatch, 0)
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{Format: models.FormatSwiss})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchListRecordKey, &result))
+
+		require.NotEmpty(t, result)
+		for _, m := range result {
+			assert.Equal(t, models.BracketSwiss, m.Bracket)
+			assert.Equal(t, 1, m.Round)
+		}
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("CreateRandomlySeededMatchSet", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := createMatchSetPipeline(setupWorkingRandomlySeededBracketBuilderContext(t))
+		var result []models.EventMatch = make([]models.EventMatch, 0)
+		var event models.EventRecord
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{Seeding: models.SeedingRandom, RandomSeed: 42})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchListRecordKey, &result))
+		require.NoError(t, after.Get(eventRecordKey, &event))
+
+		assert.Equal(t, int64(42), event.DrawSeed)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
 	t.Run("FetchMatchSet", func(t *testing.T) {
 		pCtx, pCancel, pIn, pOut := getMatchSetPipeline(setupWorkingBracketFetcherContext(t))
 		var result []models.EventMatch = make([]models.EventMatch, 0)
@@ -1492,6 +2132,52 @@ func TestEventBracketPipeline(t *testing.T) {
 		}
 	})
 
+	t.Run("FetchStandings", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := getStandingsPipeline(setupWorkingStandingsContext(t))
+		var result []models.EventStanding
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingEventLookupWorkspace(t)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(eventStandingsKey, &result))
+
+		assert.Equal(t, len(listParticipantsDocs), len(result))
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("CreateFirstSwissRound", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := createSwissRoundPipeline(setupWorkingSwissRoundContext(t))
+		var result []models.EventMatch
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.CreateMatchSetRequest{})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchListRecordKey, &result))
+
+		assert.Len(t, result, (len(listParticipantsDocs)+1)/2)
+		for _, m := range result {
+			assert.Equal(t, models.BracketSwiss, m.Bracket)
+			assert.Equal(t, 1, m.Round)
+		}
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
 	t.Run("FetchMatchByID", func(t *testing.T) {
 		pCtx, pCancel, pIn, pOut := getMatchPipeline(setupWorkingMatchFetcherContext(t))
 		var result models.EventMatch
@@ -1561,7 +2247,9 @@ func TestEventBracketPipeline(t *testing.T) {
 		defer close(pIn)
 		defer pCancel(nil)
 
-		pIn <- setupWorkingMatchUpdateWorkspace(t)
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.DeclarMatchWinnerRequest{
+			DeclareWinner: testMatch3["home"].(bson.ObjectID).Hex(),
+		})
 
 		after, ok := <-pOut
 		require.True(t, ok, "Reading value from pipeline exit channel failed")
@@ -1576,4 +2264,196 @@ func TestEventBracketPipeline(t *testing.T) {
 		}
 	})
 
+	t.Run("UpdateMatchWinnerAwardsWalkover", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := declareMatchWinnerPipeline(setupWorkingMatchWalkoverContext(t))
+		var result models.MatchID
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.DeclarMatchWinnerRequest{
+			DeclareWinner: testMatch3["away"].(bson.ObjectID).Hex(),
+		})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchIDResponseKey, &result))
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UpdateDecidedMatchRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := declareMatchWinnerPipeline(setupWorkingDecidedMatchContext(t))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.DeclarMatchWinnerRequest{
+			DeclareWinner: testMatch3["away"].(bson.ObjectID).Hex(),
+		})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrMatchAlreadyDecided)
+	})
+
+	t.Run("SubmitMatchScoreBeforeStartRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := submitMatchScorePipeline(setupWorkingMatchRevisionContext(t))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.SubmitMatchScoreRequest{
+			Games: []models.MatchGame{{Home: 1, Away: 0}},
+		})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		var rejected statusTransitionError
+		assert.ErrorAs(t, context.Cause(pCtx), &rejected)
+	})
+
+	t.Run("ReviseMatchWinnerOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := reviseMatchResultPipeline(setupWorkingMatchRevisionContext(t))
+		var result models.MatchRevisionResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.DeclarMatchWinnerRequest{
+			DeclareWinner: testMatch3["away"].(bson.ObjectID).Hex(),
+		})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchRevisionResponseKey, &result))
+
+		assert.Equal(t, testMatch3["_id"].(bson.ObjectID).Hex(), result.Match)
+		assert.Equal(t, []string{testMatch1["_id"].(bson.ObjectID).Hex()}, result.Invalidated)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("SubmitDrawnEliminationScoreRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := submitMatchScorePipeline(setupWorkingMatchWinnerUpdateContext(t))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.SubmitMatchScoreRequest{
+			Games: []models.MatchGame{{Home: 2, Away: 2}},
+		})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrMatchDrawNotAllowed)
+		_, classified := isMatchResultError(context.Cause(pCtx))
+		assert.True(t, classified)
+	})
+
+	t.Run("SubmitMatchScoreOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := submitMatchScorePipeline(setupWorkingMatchWinnerUpdateContext(t))
+		var result models.EventMatch
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingMatchUpdateWorkspace(t, models.SubmitMatchScoreRequest{
+			BestOf: 3,
+			Games:  []models.MatchGame{{Home: 1, Away: 3}, {Home: 2, Away: 0}, {Home: 0, Away: 5}},
+		})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(matchRecordKey, &result))
+
+		assert.Equal(t, testMatch3["away"].(bson.ObjectID), result.Winner)
+		assert.Equal(t, testMatch3["home"].(bson.ObjectID), result.Loser)
+		assert.Len(t, result.Games, 3)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+}
+
+func TestDeriveNextSwissRound(t *testing.T) {
+	event := models.EventRecord{ID: bson.NewObjectID(), Status: models.StatusInProgress}
+	participants := generateParticipants(t, event.ID, 4)
+	opening := swissRoundMatchSet(event.ID, participants, nil, 1)
+
+	workspaceOf := func(event models.EventRecord, previous []models.EventMatch) *handlerutil.HandlerWorkspace {
+		space := handlerutil.DefaultWorkspace()
+		space.Set(eventRecordKey, event)
+		space.Set(participantListRecordsKey, participants)
+		space.Set(matchListRecordKey, previous)
+		return &space
+	}
+
+	t.Run("ConcludedEventRefused", func(t *testing.T) {
+		concluded := event
+		concluded.Status = models.StatusConcluded
+		err := deriveNextSwissRound(context.Background(), workspaceOf(concluded, nil))
+		assert.ErrorIs(t, err, ErrSwissRoundClosed)
+	})
+
+	t.Run("EliminationMatchSetRefused", func(t *testing.T) {
+		bracket := singleEliminationMatchSet(event.ID, participants)
+		err := deriveNextSwissRound(context.Background(), workspaceOf(event, bracket))
+		assert.ErrorIs(t, err, ErrNotSwissMatchSet)
+	})
+
+	t.Run("PendingRoundRefused", func(t *testing.T) {
+		err := deriveNextSwissRound(context.Background(), workspaceOf(event, opening))
+		assert.ErrorIs(t, err, ErrSwissPreviousRoundPending)
+
+		_, classified := isSwissRoundError(err)
+		assert.True(t, classified)
+	})
+}
+
+func TestMatchSetCreationRequestBinding(t *testing.T) {
+	bind := func(t *testing.T, body io.Reader) (models.CreateMatchSetRequest, error) {
+		t.Helper()
+		var req models.CreateMatchSetRequest
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/", body)
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		space := handlerutil.DefaultWorkspace()
+		space.Set(handlerutil.RequestBindings, handlerutil.BindingsFromRequestContext(c, handlerutil.ShouldHaveJSONBody))
+		if err := bindMatchSetCreationRequestFromBody(context.Background(), &space); err != nil {
+			return req, err
+		}
+		require.NoError(t, space.Get(matchSetCreationRequest, &req))
+		return req, nil
+	}
+
+	t.Run("EmptyBodyAccepted", func(t *testing.T) {
+		req, err := bind(t, nil)
+		require.NoError(t, err)
+		assert.Equal(t, models.CreateMatchSetRequest{}, req)
+	})
+
+	t.Run("FormatBound", func(t *testing.T) {
+		req, err := bind(t, strings.NewReader(`{"format": "ROUND-ROBIN", "groups": 2}`))
+		require.NoError(t, err)
+		assert.Equal(t, models.FormatRoundRobin, req.Format)
+		assert.Equal(t, 2, req.Groups)
+	})
+
+	t.Run("UnknownFormatRejected", func(t *testing.T) {
+		_, err := bind(t, strings.NewReader(`{"format": "LADDER"}`))
+		assert.Error(t, err)
+	})
 }
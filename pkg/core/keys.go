@@ -0,0 +1,208 @@
+package core
+
+/*
+ * File: pkg/core/keys.go
+ *
+ * Purpose: access token signing keys and the public keyset used to verify them
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/tournabyte/webapi/pkg/models"
+)
+
+// Errors specific to access token signing key configuration
+var (
+	ErrNoActiveSigningKey       = errors.New("exactly one signing key must be marked active")
+	ErrUnsupportedSigningKey    = errors.New("signing key does not match its algorithm (RS256, ES256 and EdDSA are supported)")
+	ErrMissingSigningKeyID      = errors.New("signing keys must have a key ID")
+	ErrUnknownSigningKeyID      = errors.New("token was signed with an unknown key")
+	ErrDuplicateSigningKeyID    = errors.New("signing key IDs must be unique")
+	ErrMissingSharedSigningKey  = errors.New("a shared signing key is required when no signing key pairs are configured")
+	errUndecodableSigningKeyPEM = errors.New("signing key file does not contain a PEM block")
+)
+
+// Function `tokenKeysFromConfig` creates the token signer and verification keyset based on the token options in the given application configuration
+//
+// When no signing key pairs are configured, tokens are signed and verified with the shared secret instead and no keyset is produced.
+//
+// Parameters:
+//   - cfg: the application configuration to extract token options from
+//
+// Returns:
+//   - `jose.Signer`: token signer for application usage (signs with the active key)
+//   - `*jose.JSONWebKeySet`: the public keys of every configured key pair (nil when signing with a shared secret)
+//   - `error`: reported issue on failure
+func tokenKeysFromConfig(cfg *models.ApplicationOptions) (jose.Signer, *jose.JSONWebKeySet, error) {
+	var keyset jose.JSONWebKeySet
+	var active *jose.JSONWebKey
+	var seen = make(map[string]bool)
+
+	if len(cfg.Serve.Sessions.SigningKeys) == 0 {
+		if cfg.Serve.Sessions.SigningKey == "" {
+			return nil, nil, ErrMissingSharedSigningKey
+		}
+		signer, err := tokenSignerFromConfig(cfg)
+		return signer, nil, err
+	}
+
+	for _, opt := range cfg.Serve.Sessions.SigningKeys {
+		if opt.ID == "" {
+			return nil, nil, ErrMissingSigningKeyID
+		}
+		if seen[opt.ID] {
+			return nil, nil, fmt.Errorf("%w (%s)", ErrDuplicateSigningKeyID, opt.ID)
+		}
+		seen[opt.ID] = true
+
+		private, err := parseSigningKey(opt.Algorithm, opt.PrivateKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("signing key %s: %w", opt.ID, err)
+		}
+
+		jwk := jose.JSONWebKey{Key: private, KeyID: opt.ID, Algorithm: opt.Algorithm, Use: "sig"}
+		if opt.Active {
+			if active != nil {
+				return nil, nil, ErrNoActiveSigningKey
+			}
+			active = &jwk
+		}
+		keyset.Keys = append(keyset.Keys, jwk.Public())
+	}
+
+	if active == nil {
+		return nil, nil, ErrNoActiveSigningKey
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.SignatureAlgorithm(active.Algorithm),
+			Key:       *active,
+		},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signer, &keyset, nil
+}
+
+// Function `parseSigningKey` interprets a PEM encoded private key and checks it can be used with the given algorithm
+//
+// Parameters:
+//   - algorithm: the signing algorithm the key will be used with
+//   - raw: the PEM encoded private key (PKCS #8, PKCS #1 or SEC 1)
+//
+// Returns:
+//   - `crypto.Signer`: the private key
+//   - `error`: issue interpreting the key (nil if no issue occurred)
+func parseSigningKey(algorithm string, raw string) (crypto.Signer, error) {
+	var private any
+	var err error
+
+	block, _ := pem.Decode([]byte(raw))
+	if block == nil {
+		return nil, errUndecodableSigningKeyPEM
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := private.(type) {
+	case *rsa.PrivateKey:
+		if algorithm == string(jose.RS256) {
+			return key, nil
+		}
+	case *ecdsa.PrivateKey:
+		if algorithm == string(jose.ES256) && key.Curve == elliptic.P256() {
+			return key, nil
+		}
+	case ed25519.PrivateKey:
+		if algorithm == string(jose.EdDSA) {
+			return key, nil
+		}
+	}
+
+	return nil, ErrUnsupportedSigningKey
+}
+
+// Function `tokenVerificationKey` selects the key to verify a parsed access token with
+//
+// Parameters:
+//   - opts: the token options holding the verification keys
+//   - header: the protected header of the token
+//
+// Returns:
+//   - `any`: the key to verify the token with
+//   - `error`: `ErrUnknownSigningKeyID` if the token names a key not within the keyset (nil otherwise)
+func tokenVerificationKey(opts models.TokenOptions, header jose.Header) (any, error) {
+	if opts.Keyset == nil {
+		return []byte(opts.Key), nil
+	}
+
+	if keys := opts.Keyset.Key(header.KeyID); len(keys) > 0 {
+		return keys[0].Key, nil
+	}
+
+	return nil, ErrUnknownSigningKeyID
+}
+
+// Function `tokenAlgorithms` lists the signature algorithms accepted when verifying access tokens
+//
+// Parameters:
+//   - opts: the token options holding the verification keys
+//
+// Returns:
+//   - `[]jose.SignatureAlgorithm`: the accepted algorithms
+func tokenAlgorithms(opts models.TokenOptions) []jose.SignatureAlgorithm {
+	if opts.Keyset == nil {
+		return []jose.SignatureAlgorithm{jose.SignatureAlgorithm(opts.Algorithm)}
+	}
+
+	var algorithms []jose.SignatureAlgorithm
+	for _, key := range opts.Keyset.Keys {
+		algorithms = append(algorithms, jose.SignatureAlgorithm(key.Algorithm))
+	}
+	return algorithms
+}
+
+// Function `(*tournabyteAPIService).serveKeySet` responds with the public keys that verify access tokens issued by this service
+//
+// Parameters:
+//   - ctx: the request context
+func (srv *tournabyteAPIService) serveKeySet(ctx *gin.Context) {
+	keyset := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	if srv.keys != nil {
+		keyset = *srv.keys
+	}
+
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, keyset)
+}
@@ -0,0 +1,168 @@
+package core
+
+/*
+ * File: pkg/core/keys_test.go
+ *
+ * Purpose: unit tests for access token signing keys
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func encodeTestSigningKey(t *testing.T, key crypto.Signer) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func setupTestKeyConfig(t *testing.T) *models.ApplicationOptions {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var cfg models.ApplicationOptions
+	cfg.Serve.Sessions.Issuer = "testissuer"
+	cfg.Serve.Sessions.Subject = "testsubject"
+	cfg.Serve.Sessions.SigningKeys = []models.SigningKeyOptions{
+		{ID: "rsa-old", Algorithm: "RS256", PrivateKey: encodeTestSigningKey(t, rsaKey)},
+		{ID: "ec-old", Algorithm: "ES256", PrivateKey: encodeTestSigningKey(t, ecKey)},
+		{ID: "ed-current", Algorithm: "EdDSA", PrivateKey: encodeTestSigningKey(t, edKey), Active: true},
+	}
+	return &cfg
+}
+
+func setupKeyVerificationWorkspace(t *testing.T, opts models.TokenOptions, token string) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := handlerutil.DefaultWorkspace()
+
+	space.Set(authTokenOptionsKey, opts)
+	space.Set(activeAccessToken, token)
+	space.Set(models.ValidatorObjectKey, validator.New())
+	return &space
+}
+
+func signTestToken(t *testing.T, signer jose.Signer) string {
+	t.Helper()
+
+	token, err := jwt.Signed(signer).
+		Claims(jwt.Claims{
+			Issuer:   "testissuer",
+			Subject:  "testsubject",
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}).
+		Claims(models.AuthorizationTokenClaims{Me: bson.NewObjectID().Hex()}).
+		Serialize()
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestTokenKeysFromConfig(t *testing.T) {
+	t.Run("KeysetPublishesPublicKeysOnly", func(t *testing.T) {
+		_, keyset, err := tokenKeysFromConfig(setupTestKeyConfig(t))
+		require.NoError(t, err)
+		require.NotNil(t, keyset)
+
+		require.Len(t, keyset.Keys, 3)
+		for _, key := range keyset.Keys {
+			assert.True(t, key.IsPublic(), "keyset entry %s should not expose private material", key.KeyID)
+		}
+	})
+
+	t.Run("SharedSecretFallback", func(t *testing.T) {
+		var cfg models.ApplicationOptions
+		cfg.Serve.Sessions.Algorithm = "HS256"
+		cfg.Serve.Sessions.SigningKey = `1010101010101010101010101010101010101010101010101010101010101010`
+
+		signer, keyset, err := tokenKeysFromConfig(&cfg)
+		require.NoError(t, err)
+		assert.NotNil(t, signer)
+		assert.Nil(t, keyset)
+	})
+
+	t.Run("ActiveKeyRequired", func(t *testing.T) {
+		cfg := setupTestKeyConfig(t)
+		cfg.Serve.Sessions.SigningKeys[2].Active = false
+
+		_, _, err := tokenKeysFromConfig(cfg)
+		assert.ErrorIs(t, err, ErrNoActiveSigningKey)
+	})
+
+	t.Run("MismatchedAlgorithmRejected", func(t *testing.T) {
+		cfg := setupTestKeyConfig(t)
+		cfg.Serve.Sessions.SigningKeys[0].Algorithm = "ES256"
+
+		_, _, err := tokenKeysFromConfig(cfg)
+		assert.ErrorIs(t, err, ErrUnsupportedSigningKey)
+	})
+}
+
+func TestAccessTokenKeyRotation(t *testing.T) {
+	cfg := setupTestKeyConfig(t)
+	signer, keyset, err := tokenKeysFromConfig(cfg)
+	require.NoError(t, err)
+
+	opts := models.TokenOptions{Issuer: "testissuer", Subject: "testsubject", Signer: signer, Keyset: keyset}
+
+	t.Run("ActiveKeyVerifies", func(t *testing.T) {
+		token := signTestToken(t, signer)
+		parsed, err := jwt.ParseSigned(token, tokenAlgorithms(opts))
+		require.NoError(t, err)
+		assert.Equal(t, "ed-current", parsed.Headers[0].KeyID)
+
+		assert.NoError(t, validateAccessToken(context.Background(), setupKeyVerificationWorkspace(t, opts, token)))
+	})
+
+	t.Run("RotatedKeyStillVerifies", func(t *testing.T) {
+		private, err := parseSigningKey("RS256", cfg.Serve.Sessions.SigningKeys[0].PrivateKey)
+		require.NoError(t, err)
+		old, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: private, KeyID: "rsa-old"}}, nil)
+		require.NoError(t, err)
+
+		assert.NoError(t, validateAccessToken(context.Background(), setupKeyVerificationWorkspace(t, opts, signTestToken(t, old))))
+	})
+
+	t.Run("UnknownKeyRejected", func(t *testing.T) {
+		_, stranger, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		foreign, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.EdDSA, Key: jose.JSONWebKey{Key: stranger, KeyID: "stranger"}}, nil)
+		require.NoError(t, err)
+
+		err = validateAccessToken(context.Background(), setupKeyVerificationWorkspace(t, opts, signTestToken(t, foreign)))
+		assert.ErrorIs(t, err, ErrUnknownSigningKeyID)
+	})
+}
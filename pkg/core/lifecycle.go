@@ -0,0 +1,114 @@
+package core
+
+/*
+ * File: pkg/core/lifecycle.go
+ *
+ * Purpose: event status transition rules
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Mapping of event statuses to the statuses an event can move to from them
+var eventStatusTransitions = map[string][]string{
+	models.StatusPlanned:    {models.StatusInProgress, models.StatusCancelled},
+	models.StatusInProgress: {models.StatusConcluded, models.StatusCancelled},
+}
+
+// Type `statusTransitionError` represents a rejected event status transition
+//
+// Members:
+//   - from: the status the event is currently in
+//   - to: the status that was requested
+//   - reason: why the transition was rejected
+type statusTransitionError struct {
+	from   string
+	to     string
+	reason string
+}
+
+// Function `statusTransitionError.Error` implements the error interface for the statusTransitionError type
+func (e statusTransitionError) Error() string {
+	return fmt.Sprintf("event cannot move from %s to %s (%s)", e.from, e.to, e.reason)
+}
+
+// Function `isStatusTransitionError` determines if the given error is a rejected event status transition
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isStatusTransitionError(e error) (error, bool) {
+	var rejected statusTransitionError
+	if errors.As(e, &rejected) {
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("status transition", rejected.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `checkStatusTransition` determines if an event is allowed to move between the given statuses
+//
+// Parameters:
+//   - from: the status the event is currently in
+//   - to: the status that was requested
+//
+// Returns:
+//   - `error`: a `statusTransitionError` if the transition is not allowed (nil otherwise)
+func checkStatusTransition(from string, to string) error {
+	if !slices.Contains(eventStatusTransitions[from], to) {
+		return statusTransitionError{from: from, to: to, reason: "transition not allowed"}
+	}
+	return nil
+}
+
+// Function `matchSetConcluded` determines if every match deciding the outcome of an event has a result
+//
+// A match decides the outcome of the event when its winner does not advance into another match (e.g. the final of an elimination bracket or any round-robin
+// match). Matches between two byes are never played and are ignored.
+//
+// Parameters:
+//   - matches: the match set of the event
+//
+// Returns:
+//   - `bool`: whether the event has a final result
+func matchSetConcluded(matches []models.EventMatch) bool {
+	advances := make(map[bson.ObjectID]bool)
+	for _, m := range matches {
+		if m.HomeSourceRef == models.ParticipantFieldReferencesMatch {
+			advances[m.HomeSource] = true
+		}
+		if m.AwaySourceRef == models.ParticipantFieldReferencesMatch {
+			advances[m.AwaySource] = true
+		}
+	}
+
+	for _, m := range matches {
+		if advances[m.ID] {
+			continue
+		}
+		if m.HomeRef == models.ParticipantFieldReferencesBye && m.AwayRef == models.ParticipantFieldReferencesBye {
+			continue
+		}
+		if m.Winner == bson.NilObjectID && !m.Draw {
+			return false
+		}
+	}
+
+	return len(matches) > 0
+}
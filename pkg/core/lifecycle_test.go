@@ -0,0 +1,80 @@
+package core
+
+/*
+ * File: pkg/core/lifecycle_test.go
+ *
+ * Purpose: unit tests for the event status transition rules
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestCheckStatusTransition(t *testing.T) {
+	assert.NoError(t, checkStatusTransition(models.StatusPlanned, models.StatusInProgress))
+	assert.NoError(t, checkStatusTransition(models.StatusPlanned, models.StatusCancelled))
+	assert.NoError(t, checkStatusTransition(models.StatusInProgress, models.StatusConcluded))
+	assert.NoError(t, checkStatusTransition(models.StatusInProgress, models.StatusCancelled))
+
+	assert.Error(t, checkStatusTransition(models.StatusPlanned, models.StatusConcluded))
+	assert.Error(t, checkStatusTransition(models.StatusConcluded, models.StatusPlanned))
+	assert.Error(t, checkStatusTransition(models.StatusCancelled, models.StatusInProgress))
+	assert.Error(t, checkStatusTransition(models.StatusConcluded, models.StatusCancelled))
+}
+
+func TestIsStatusTransitionError(t *testing.T) {
+	wrapped, ok := isStatusTransitionError(checkStatusTransition(models.StatusConcluded, models.StatusPlanned))
+	require.True(t, ok)
+	assert.Equal(t, handlerutil.ErrConstraintsNotSatisfied().Error(), wrapped.Error())
+
+	_, ok = isStatusTransitionError(errors.New("unrelated failure"))
+	assert.False(t, ok)
+}
+
+func TestMatchSetConcluded(t *testing.T) {
+	event := bson.NewObjectID()
+
+	t.Run("EliminationFinal", func(t *testing.T) {
+		matches := singleEliminationMatchSet(event, generateParticipants(t, event, 5))
+		assert.False(t, matchSetConcluded(matches))
+
+		matches[0].Winner = bson.NewObjectID()
+		assert.True(t, matchSetConcluded(matches))
+	})
+
+	t.Run("DoubleEliminationReset", func(t *testing.T) {
+		matches := doubleEliminationMatchSet(event, generateParticipants(t, event, 6), true)
+		matches[len(matches)-2].Winner = bson.NewObjectID()
+		assert.False(t, matchSetConcluded(matches))
+
+		matches[len(matches)-1].Winner = bson.NewObjectID()
+		assert.True(t, matchSetConcluded(matches))
+	})
+
+	t.Run("RoundRobinDraws", func(t *testing.T) {
+		matches := roundRobinMatchSet(event, generateParticipants(t, event, 3), 1)
+		for i := range matches {
+			matches[i].Draw = true
+		}
+		assert.True(t, matchSetConcluded(matches))
+
+		matches[0].Draw = false
+		assert.False(t, matchSetConcluded(matches))
+	})
+
+	t.Run("EmptyMatchSet", func(t *testing.T) {
+		assert.False(t, matchSetConcluded(nil))
+	})
+}
@@ -0,0 +1,236 @@
+package core
+
+/*
+ * File: pkg/core/listing.go
+ *
+ * Purpose: filtering, ordering and cursor pagination for record listings
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"encoding/base64"
+	"errors"
+	"regexp"
+
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Error returned when a page cursor presented by a client cannot be interpreted
+var errInvalidCursor = errors.New("page cursor is not valid")
+
+// Type `pageCursor` represents the position of a page within a listing as the sort key of the last record of the previous page
+//
+// Pages are selected by the sort key rather than by counting records, so records inserted or archived between requests never cause a page to skip or
+// repeat records.
+//
+// Members:
+//   - Field: the name of the primary sort field the cursor was created for (empty when sorting by record ID only)
+//   - Value: the primary sort field value of the last record of the previous page (nil if the record has no value for it)
+//   - ID: the record ID of the last record of the previous page
+type pageCursor struct {
+	Field string        `bson:"f,omitempty"`
+	Value any           `bson:"v"`
+	ID    bson.ObjectID `bson:"i"`
+}
+
+// Function `encodePageCursor` creates the opaque cursor representation of a listing position
+//
+// Parameters:
+//   - position: the sort key of the last record of the page
+//
+// Returns:
+//   - `string`: the cursor to present to clients
+func encodePageCursor(position pageCursor) string {
+	raw, _ := bson.Marshal(position)
+	return base64.URLEncoding.EncodeToString(raw)
+}
+
+// Function `decodePageCursor` interprets an opaque cursor presented by a client as a listing position
+//
+// Parameters:
+//   - cursor: the cursor to interpret (the first page if empty)
+//
+// Returns:
+//   - `*pageCursor`: the sort key of the last record of the previous page (nil for the first page)
+//   - `error`: `errInvalidCursor` if the cursor could not be interpreted (nil otherwise)
+func decodePageCursor(cursor string) (*pageCursor, error) {
+	var position pageCursor
+
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	if err := bson.Unmarshal(raw, &position); err != nil || position.ID.IsZero() {
+		return nil, errInvalidCursor
+	}
+
+	return &position, nil
+}
+
+// Function `keysetCondition` creates the database filter selecting the records ordered after the given listing position
+//
+// Records without a value for the primary sort field are ordered before every other record, matching the ordering applied by the database.
+//
+// Parameters:
+//   - ordering: the sort keys of the listing (the last key being the record ID)
+//   - position: the sort key of the last record of the previous page
+//
+// Returns:
+//   - `bson.D`: the filter selecting the records of the following pages
+//   - `error`: `errInvalidCursor` if the cursor was created for a different ordering (nil otherwise)
+func keysetCondition(ordering []bson.E, position pageCursor) (bson.D, error) {
+	after := func(direction any) string {
+		if direction == -1 {
+			return "$lt"
+		}
+		return "$gt"
+	}
+
+	tiebreak := ordering[len(ordering)-1]
+	if len(ordering) == 1 {
+		if position.Field != "" {
+			return nil, errInvalidCursor
+		}
+		return bson.D{{Key: "_id", Value: bson.D{{Key: after(tiebreak.Value), Value: position.ID}}}}, nil
+	}
+
+	primary := ordering[0]
+	if position.Field != primary.Key {
+		return nil, errInvalidCursor
+	}
+
+	sameKey := bson.D{
+		{Key: primary.Key, Value: position.Value},
+		{Key: "_id", Value: bson.D{{Key: after(tiebreak.Value), Value: position.ID}}},
+	}
+	branches := bson.A{sameKey}
+	switch {
+	case position.Value == nil && primary.Value != -1:
+		branches = append(branches, bson.D{{Key: primary.Key, Value: bson.D{{Key: "$ne", Value: nil}}}})
+	case position.Value != nil:
+		branches = append(branches, bson.D{{Key: primary.Key, Value: bson.D{{Key: after(primary.Value), Value: position.Value}}}})
+		if primary.Value == -1 {
+			branches = append(branches, bson.D{{Key: primary.Key, Value: nil}})
+		}
+	}
+
+	return bson.D{{Key: "$or", Value: branches}}, nil
+}
+
+// Function `isInvalidCursorError` determines if the given error is an uninterpretable page cursor
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isInvalidCursorError(e error) (error, bool) {
+	if errors.Is(e, errInvalidCursor) {
+		return handlerutil.ErrBadRequest(
+			handlerutil.NewDetail("cursor", e.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `eventListFilter` creates the database filter selecting the events matching an event listing request
+//
+// Archived events are never included.
+//
+// Parameters:
+//   - req: the listing request to create the filter for
+//
+// Returns:
+//   - `bson.D`: the filter to apply to the events collection
+//   - `error`: issue interpreting the host ID of the request (nil if no issue occurred)
+func eventListFilter(req models.ListEventsRequest) (bson.D, error) {
+	filter := bson.D{{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+
+	if req.Host != "" {
+		host, err := bson.ObjectIDFromHex(req.Host)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, bson.E{Key: "host", Value: host})
+	}
+
+	if req.Game != "" {
+		filter = append(filter, bson.E{Key: "game", Value: req.Game})
+	}
+
+	if req.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: req.Status})
+	}
+
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "name", Value: bson.Regex{Pattern: regexp.QuoteMeta(req.Search), Options: "i"}})
+	}
+
+	var created bson.D
+	if !req.CreatedAfter.IsZero() {
+		created = append(created, bson.E{Key: "$gte", Value: req.CreatedAfter.UTC()})
+	}
+	if !req.CreatedBefore.IsZero() {
+		created = append(created, bson.E{Key: "$lt", Value: req.CreatedBefore.UTC()})
+	}
+	if len(created) > 0 {
+		filter = append(filter, bson.E{Key: "metadata.created_at", Value: created})
+	}
+
+	return filter, nil
+}
+
+// Function `eventListOrdering` creates the database sort keys corresponding to an event listing ordering
+//
+// The record ID is always used as the final sort key so that every record has a distinct position to resume a listing from.
+//
+// Parameters:
+//   - sort: the requested ordering (newest first if empty)
+//
+// Returns:
+//   - `[]bson.E`: the sort keys to apply to the find operation
+func eventListOrdering(sort string) []bson.E {
+	switch sort {
+	case models.SortByName:
+		return []bson.E{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}
+	case models.SortByNameDesc:
+		return []bson.E{{Key: "name", Value: -1}, {Key: "_id", Value: -1}}
+	case models.SortByCreatedAt:
+		return []bson.E{{Key: "metadata.created_at", Value: 1}, {Key: "_id", Value: 1}}
+	default:
+		return []bson.E{{Key: "metadata.created_at", Value: -1}, {Key: "_id", Value: -1}}
+	}
+}
+
+// Function `eventListPosition` creates the listing position of the given event under an event listing ordering
+//
+// Parameters:
+//   - sort: the requested ordering (newest first if empty)
+//   - event: the event to create the position of
+//
+// Returns:
+//   - `pageCursor`: the position of the event
+func eventListPosition(sort string, event models.EventRecord) pageCursor {
+	position := pageCursor{Field: eventListOrdering(sort)[0].Key, ID: event.ID}
+	switch sort {
+	case models.SortByName, models.SortByNameDesc:
+		position.Value = event.Name
+	default:
+		if !event.Metadata.CreatedAt.IsZero() {
+			position.Value = event.Metadata.CreatedAt
+		}
+	}
+	return position
+}
@@ -0,0 +1,129 @@
+package core
+
+/*
+ * File: pkg/core/listing_test.go
+ *
+ * Purpose: unit tests for the listing filter and pagination logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestPageCursor(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		id := bson.NewObjectID()
+		position, err := decodePageCursor(encodePageCursor(pageCursor{Field: "name", Value: "Spring Open", ID: id}))
+		require.NoError(t, err)
+		assert.Equal(t, "name", position.Field)
+		assert.Equal(t, "Spring Open", position.Value)
+		assert.Equal(t, id, position.ID)
+	})
+
+	t.Run("EmptyIsFirstPage", func(t *testing.T) {
+		position, err := decodePageCursor("")
+		require.NoError(t, err)
+		assert.Nil(t, position)
+	})
+
+	t.Run("GarbageRejected", func(t *testing.T) {
+		_, err := decodePageCursor("%%%")
+		assert.ErrorIs(t, err, errInvalidCursor)
+
+		_, err = decodePageCursor("bm90LWEtY3Vyc29y")
+		assert.ErrorIs(t, err, errInvalidCursor)
+	})
+}
+
+func TestKeysetCondition(t *testing.T) {
+	id := bson.NewObjectID()
+
+	t.Run("RecordIDOnly", func(t *testing.T) {
+		cond, err := keysetCondition([]bson.E{{Key: "_id", Value: 1}}, pageCursor{ID: id})
+		require.NoError(t, err)
+		assert.Equal(t, bson.D{{Key: "_id", Value: bson.D{{Key: "$gt", Value: id}}}}, cond)
+	})
+
+	t.Run("AscendingResumesAfterKey", func(t *testing.T) {
+		cond, err := keysetCondition(eventListOrdering(models.SortByName), pageCursor{Field: "name", Value: "Spring Open", ID: id})
+		require.NoError(t, err)
+		assert.Equal(t, bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "name", Value: "Spring Open"}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: id}}}},
+			bson.D{{Key: "name", Value: bson.D{{Key: "$gt", Value: "Spring Open"}}}},
+		}}}, cond)
+	})
+
+	t.Run("DescendingIncludesMissingKeys", func(t *testing.T) {
+		at := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+		cond, err := keysetCondition(eventListOrdering(""), pageCursor{Field: "metadata.created_at", Value: at, ID: id})
+		require.NoError(t, err)
+		assert.Equal(t, bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "metadata.created_at", Value: at}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: id}}}},
+			bson.D{{Key: "metadata.created_at", Value: bson.D{{Key: "$lt", Value: at}}}},
+			bson.D{{Key: "metadata.created_at", Value: nil}},
+		}}}, cond)
+	})
+
+	t.Run("MismatchedOrderingRejected", func(t *testing.T) {
+		_, err := keysetCondition(eventListOrdering(models.SortByName), pageCursor{Field: "metadata.created_at", ID: id})
+		assert.ErrorIs(t, err, errInvalidCursor)
+	})
+}
+
+func TestEventListFilter(t *testing.T) {
+	t.Run("OnlyActiveByDefault", func(t *testing.T) {
+		filter, err := eventListFilter(models.ListEventsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, bson.D{{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}, filter)
+	})
+
+	t.Run("AllCriteria", func(t *testing.T) {
+		host := bson.NewObjectID()
+		after := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		filter, err := eventListFilter(models.ListEventsRequest{
+			Host:         host.Hex(),
+			Game:         "Chess",
+			Status:       models.StatusPlanned,
+			Search:       "open (u18)",
+			CreatedAfter: after,
+		})
+		require.NoError(t, err)
+
+		m := make(map[string]any)
+		for _, e := range filter {
+			m[e.Key] = e.Value
+		}
+		assert.Equal(t, host, m["host"])
+		assert.Equal(t, "Chess", m["game"])
+		assert.Equal(t, models.StatusPlanned, m["status"])
+		assert.Equal(t, bson.Regex{Pattern: `open \(u18\)`, Options: "i"}, m["name"])
+		assert.Equal(t, bson.D{{Key: "$gte", Value: after}}, m["metadata.created_at"])
+	})
+
+	t.Run("BadHostRejected", func(t *testing.T) {
+		_, err := eventListFilter(models.ListEventsRequest{Host: "nobody"})
+		assert.Error(t, err)
+	})
+}
+
+func TestEventListOrdering(t *testing.T) {
+	assert.Equal(t, bson.E{Key: "metadata.created_at", Value: -1}, eventListOrdering("")[0])
+	assert.Equal(t, bson.E{Key: "name", Value: 1}, eventListOrdering(models.SortByName)[0])
+
+	for _, sort := range []string{"", models.SortByName, models.SortByNameDesc, models.SortByCreatedAt, models.SortByCreatedAtDesc} {
+		keys := eventListOrdering(sort)
+		assert.Equal(t, "_id", keys[len(keys)-1].Key)
+	}
+}
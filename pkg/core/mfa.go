@@ -0,0 +1,946 @@
+package core
+
+/*
+ * File: pkg/core/mfa.go
+ *
+ * Purpose: TOTP based two-factor authentication logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Parameters of the TOTP codes accepted for two-factor authentication (RFC 6238 defaults understood by common authenticator apps)
+const (
+	totpIssuer        = "Tournabyte"
+	totpPeriod        = 30
+	totpDigits        = 6
+	totpSkew          = 1
+	totpSecretSize    = 20
+	recoveryCodeCount = 10
+	recoveryCodeSize  = 16
+)
+
+// Duration a login awaiting an authentication code remains valid when not configured
+const defaultMFAChallengeTTL = 5 * time.Minute
+
+// Workspace keys associated with two-factor authentication workspace tasks
+const (
+	mfaOptionsKey             = "mfaOptions"
+	mfaCodeKey                = "mfaCode"
+	mfaVerificationRequestKey = "mfaVerificationRequest"
+	mfaChallengeTokenKey      = "mfaChallengeToken"
+	mfaChallengeRecordKey     = "mfaChallengeRecord"
+	mfaAcceptedStepKey        = "mfaAcceptedStep"
+	mfaAcceptedRecoveryKey    = "mfaAcceptedRecoveryCode"
+	mfaResponseKey            = "mfaResponse"
+)
+
+// Errors specific to two-factor authentication workflow tasks
+var (
+	ErrMFAAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrMFANotEnrolled      = errors.New("no authenticator is awaiting confirmation")
+	ErrInvalidMFAChallenge = errors.New("login challenge is invalid or expired")
+	ErrInvalidMFACode      = fmt.Errorf("%w: authentication code rejected", ErrInvalidLogin)
+)
+
+// Encoding of TOTP secrets expected by authenticator apps
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Function `(*tournabyteAPIService).initMFAWorkspace` initializes the handler workspace for a two-factor authentication management handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for managing the authenticator of the current user
+func (srv *tournabyteAPIService) initMFAWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(loginThrottleKey, srv.throttle)
+	space.Set(requestClientKey, models.ClientDetails{UserAgent: ctx.Request.UserAgent(), IPAddress: ctx.ClientIP()})
+	log.Printf("[HANDLER]: setup request bindings and token configurations")
+	return &space
+}
+
+// Function `mfaEnrollmentPipeline` initializes a handling pipeline for generating an authenticator secret for the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func mfaEnrollmentPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireMFADisabled, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, startMFAEnrollment, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `mfaConfirmationPipeline` initializes a handling pipeline for enabling two-factor authentication with a first code from the pending authenticator
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func mfaConfirmationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMFACodeRequest, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireMFADisabled, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, checkLoginThrottle, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, countingLoginFailures(verifyPendingMFACode), out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, enableMFA, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `mfaRemovalPipeline` initializes a handling pipeline for disabling two-factor authentication of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func mfaRemovalPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMFACodeRequest, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireMFAEnabled, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, checkLoginThrottle, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, countingLoginFailures(verifyMFACode), out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, disableMFA, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `mfaVerificationPipeline` initializes a handling pipeline for completing a challenged login with an authentication code
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func mfaVerificationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindMFAVerificationRequest, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchMFAChallenge, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireMFAEnabled, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, checkLoginThrottle, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, countingLoginFailures(verifyMFACode), out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, recordMFACodeUse, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, deleteMFAChallenge, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, resetLoginThrottle, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, createAccessToken, out9)
+	out11 := handlerutil.Stage(pipelineCtx, pipelineCancel, createRefreshToken, out10)
+	out12 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveSessionRecord, out11)
+	out13 := handlerutil.Stage(pipelineCtx, pipelineCancel, createSessionRecord, out12)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateUserAuthorizationResponse, out13)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindMFACodeRequest` binds the request body and saves the presented authentication code to the handler workspace
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the two-factor authentication process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindMFACodeRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.MFACodeRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(mfaCodeKey, body.Code)
+	log.Printf("[HANDLER]: saved authentication code within workspace under key %q", mfaCodeKey)
+	return nil
+}
+
+// Function `bindMFAVerificationRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the two-factor authentication process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindMFAVerificationRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.MFAVerificationRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(mfaVerificationRequestKey, body)
+	space.Set(mfaCodeKey, body.Code)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, mfaVerificationRequestKey)
+	return nil
+}
+
+// Function `requireMFADisabled` rejects requests of accounts that already completed two-factor authentication enrollment
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrMFAAlreadyEnabled` if two-factor authentication is enabled (nil otherwise)
+func requireMFADisabled(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	if acct.MFA != nil && acct.MFA.Enabled {
+		log.Printf("[HANDLER]: account (_id=%q) already has two-factor authentication enabled", acct.ID.Hex())
+		return ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+// Function `requireMFAEnabled` rejects requests of accounts without two-factor authentication
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrMFANotEnabled` if two-factor authentication is not enabled (nil otherwise)
+func requireMFAEnabled(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	if acct.MFA == nil || !acct.MFA.Enabled {
+		log.Printf("[HANDLER]: account (_id=%q) does not have two-factor authentication enabled", acct.ID.Hex())
+		return ErrMFANotEnabled
+	}
+	return nil
+}
+
+// Function `startMFAEnrollment` generates a new authenticator secret and stores it with the account until it is confirmed
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func startMFAEnrollment(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var now = time.Now().UTC()
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating authenticator secret...")
+	secret := newTOTPSecret()
+
+	if err := updateAccountMFA(
+		ctx,
+		bson.D{{Key: "_id", Value: acct.ID}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "mfa.pending_secret", Value: secret}, {Key: "metadata.updated_at", Value: now}}}},
+		mongo.ErrNoDocuments,
+	); err != nil {
+		return err
+	}
+
+	space.Set(mfaResponseKey, models.MFAEnrollment{Secret: secret, URI: totpURI(acct.LoginEmail, secret)})
+	log.Printf("[HANDLER]: authenticator of account (_id=%q) awaiting confirmation", acct.ID.Hex())
+	return nil
+}
+
+// Function `verifyPendingMFACode` compares the presented authentication code with the authenticator awaiting confirmation
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidMFACode` if the code does not match (or another error that occurred during this processing step)
+func verifyPendingMFACode(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var code string
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading authentication code from workspace under %q...", mfaCodeKey)
+	if err := space.Get(mfaCodeKey, &code); err != nil {
+		log.Printf("[HANDLER]: error loading authentication code (%s)", err.Error())
+		return err
+	}
+
+	if acct.MFA == nil || acct.MFA.PendingSecret == "" {
+		log.Printf("[HANDLER]: account (_id=%q) has no authenticator awaiting confirmation", acct.ID.Hex())
+		return ErrMFANotEnrolled
+	}
+
+	log.Printf("[HANDLER]: comparing authentication code with the pending authenticator...")
+	step, ok := matchTOTP(acct.MFA.PendingSecret, code, time.Now(), 0)
+	if !ok {
+		log.Printf("[HANDLER]: authentication code does not match the pending authenticator")
+		return ErrInvalidMFACode
+	}
+
+	space.Set(mfaAcceptedStepKey, step)
+	return nil
+}
+
+// Function `enableMFA` promotes the confirmed authenticator of the account and issues its recovery codes
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrMFANotEnrolled` if the pending authenticator changed meanwhile (or another error that occurred during this processing step)
+func enableMFA(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var step int64
+	var now = time.Now().UTC()
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading accepted time step from workspace under %q...", mfaAcceptedStepKey)
+	if err := space.Get(mfaAcceptedStepKey, &step); err != nil {
+		log.Printf("[HANDLER]: error loading accepted time step (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating recovery codes...")
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code := rand.Text()[:recoveryCodeSize]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	if err := updateAccountMFA(
+		ctx,
+		bson.D{{Key: "_id", Value: acct.ID}, {Key: "mfa.pending_secret", Value: acct.MFA.PendingSecret}},
+		bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "mfa.enabled", Value: true},
+				{Key: "mfa.secret", Value: acct.MFA.PendingSecret},
+				{Key: "mfa.recovery_codes", Value: hashes},
+				{Key: "mfa.last_step", Value: step},
+				{Key: "mfa.enabled_at", Value: now},
+				{Key: "metadata.updated_at", Value: now},
+			}},
+			{Key: "$unset", Value: bson.D{{Key: "mfa.pending_secret", Value: ""}}},
+		},
+		ErrMFANotEnrolled,
+	); err != nil {
+		return err
+	}
+
+	space.Set(mfaResponseKey, models.MFARecoveryCodes{RecoveryCodes: codes})
+	log.Printf("[HANDLER]: two-factor authentication of account (_id=%q) enabled", acct.ID.Hex())
+	return nil
+}
+
+// Function `disableMFA` removes the authenticator and recovery codes of the account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func disableMFA(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var now = time.Now().UTC()
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	if err := updateAccountMFA(
+		ctx,
+		bson.D{{Key: "_id", Value: acct.ID}},
+		bson.D{
+			{Key: "$unset", Value: bson.D{{Key: "mfa", Value: ""}}},
+			{Key: "$set", Value: bson.D{{Key: "metadata.updated_at", Value: now}}},
+		},
+		mongo.ErrNoDocuments,
+	); err != nil {
+		return err
+	}
+
+	space.Set(mfaResponseKey, gin.H{"mfaDisabled": now})
+	log.Printf("[HANDLER]: two-factor authentication of account (_id=%q) disabled", acct.ID.Hex())
+	return nil
+}
+
+// Function `verifyMFACode` compares the presented authentication code with the authenticator and recovery codes of the account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidMFACode` if the code matches neither (or another error that occurred during this processing step)
+func verifyMFACode(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var code string
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading authentication code from workspace under %q...", mfaCodeKey)
+	if err := space.Get(mfaCodeKey, &code); err != nil {
+		log.Printf("[HANDLER]: error loading authentication code (%s)", err.Error())
+		return err
+	}
+
+	if acct.MFA == nil {
+		log.Printf("[HANDLER]: account (_id=%q) has no authenticator", acct.ID.Hex())
+		return ErrMFANotEnabled
+	}
+
+	log.Printf("[HANDLER]: comparing authentication code with the authenticator...")
+	if step, ok := matchTOTP(acct.MFA.Secret, code, time.Now(), acct.MFA.LastStep); ok {
+		space.Set(mfaAcceptedStepKey, step)
+		return nil
+	}
+
+	log.Printf("[HANDLER]: comparing authentication code with the recovery codes...")
+	if hash := hashRecoveryCode(code); slices.Contains(acct.MFA.RecoveryCodes, hash) {
+		space.Set(mfaAcceptedRecoveryKey, hash)
+		return nil
+	}
+
+	log.Printf("[HANDLER]: authentication code matches neither the authenticator nor a recovery code")
+	return ErrInvalidMFACode
+}
+
+// Function `recordMFACodeUse` marks the accepted authentication code as used so it cannot be presented again
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidMFACode` if the code was used by a concurrent request (or another error that occurred during this processing step)
+func recordMFACodeUse(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var step int64
+	var hash string
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	if err := space.Get(mfaAcceptedRecoveryKey, &hash); err == nil {
+		log.Printf("[HANDLER]: consuming recovery code...")
+		return updateAccountMFA(
+			ctx,
+			bson.D{{Key: "_id", Value: acct.ID}, {Key: "mfa.recovery_codes", Value: hash}},
+			bson.D{{Key: "$pull", Value: bson.D{{Key: "mfa.recovery_codes", Value: hash}}}},
+			ErrInvalidMFACode,
+		)
+	} else if !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading accepted recovery code (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading accepted time step from workspace under %q...", mfaAcceptedStepKey)
+	if err := space.Get(mfaAcceptedStepKey, &step); err != nil {
+		log.Printf("[HANDLER]: error loading accepted time step (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: advancing last accepted time step...")
+	return updateAccountMFA(
+		ctx,
+		bson.D{{Key: "_id", Value: acct.ID}, {Key: "mfa.last_step", Value: bson.D{{Key: "$lt", Value: step}}}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "mfa.last_step", Value: step}}}},
+		ErrInvalidMFACode,
+	)
+}
+
+// Function `challengeMFALogin` issues a login challenge instead of tokens when the authenticated account has two-factor authentication enabled
+//
+// Accounts without two-factor authentication pass through unchanged. Later token issuing steps are skipped once a challenge is issued.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func challengeMFALogin(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var opts models.MFAOptions
+	var sess *mongo.Session
+	var cfg *options.InsertOneOptionsBuilder
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	if acct.MFA == nil || !acct.MFA.Enabled {
+		log.Printf("[HANDLER]: account (_id=%q) does not require an authentication code", acct.ID.Hex())
+		return nil
+	}
+
+	log.Printf("[HANDLER]: loading two-factor authentication options from workspace under %q key...", mfaOptionsKey)
+	if err = space.Get(mfaOptionsKey, &opts); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading two-factor authentication options (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating login challenge...")
+	raw := rand.Text()
+	record := models.MFAChallenge{
+		ID:            fmt.Sprintf("%x", sha256.Sum256([]byte(raw))),
+		Authorizes:    acct.ID,
+		NotValidAfter: now.Add(cmp.Or(opts.ChallengeExpiresIn, defaultMFAChallengeTTL)),
+		CreatedAt:     now,
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation")
+	if _, err = sess.Client().
+		Database(models.MFAChallengeQueryContext.Database).
+		Collection(models.MFAChallengeQueryContext.Collection).
+		InsertOne(ctx, record, cfg); err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(mfaChallengeTokenKey, raw)
+	space.Set(userAuthorizationResponseKey, models.MFAChallengeResponse{
+		ID:             acct.ID.Hex(),
+		MFARequired:    true,
+		ChallengeToken: raw,
+		ExpiresAt:      record.NotValidAfter,
+	})
+	log.Printf("[HANDLER]: login challenge issued (authorizes=%q, expires=%s)", acct.ID.Hex(), record.NotValidAfter)
+	return nil
+}
+
+// Function `unlessMFAChallenged` creates a processing step that runs the given step only when no login challenge was issued
+//
+// Parameters:
+//   - step: the processing step to skip for challenged logins
+//
+// Returns:
+//   - `func(context.Context, *handlerutil.HandlerWorkspace) error`: the processing step running the given step for unchallenged logins
+func unlessMFAChallenged(step handlerutil.TransitionFn) func(context.Context, *handlerutil.HandlerWorkspace) error {
+	return func(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+		var raw string
+		if err := space.Get(mfaChallengeTokenKey, &raw); err == nil {
+			return nil
+		} else if !errors.Is(err, handlerutil.ErrKeyNotExists) {
+			return err
+		}
+		return step(ctx, space)
+	}
+}
+
+// Function `fetchMFAChallenge` retrieves the unexpired login challenge matching the presented challenge token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidMFAChallenge` if no unexpired challenge matches (or another error that occurred during this processing step)
+func fetchMFAChallenge(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.MFAVerificationRequest
+	var record models.MFAChallenge
+	var sess *mongo.Session
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading two-factor verification request from workspace under key %q", mfaVerificationRequestKey)
+	if err = space.Get(mfaVerificationRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading two-factor verification request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{
+		{Key: "_id", Value: fmt.Sprintf("%x", sha256.Sum256([]byte(req.ChallengeToken)))},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: now}}},
+	}
+	err = sess.Client().
+		Database(models.MFAChallengeQueryContext.Database).
+		Collection(models.MFAChallengeQueryContext.Collection).
+		FindOne(ctx, filter).
+		Decode(&record)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no unexpired login challenge matches the one presented")
+		return ErrInvalidMFAChallenge
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	space.Set(mfaChallengeRecordKey, record)
+	space.Set(activeUserID, record.Authorizes.Hex())
+	log.Printf("[HANDLER]: saved login challenge as variable of type %T within workspace under key %q", record, mfaChallengeRecordKey)
+	return nil
+}
+
+// Function `deleteMFAChallenge` removes the completed login challenge so it cannot be presented again
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidMFAChallenge` if the challenge was completed by a concurrent request (or another error that occurred during this processing step)
+func deleteMFAChallenge(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var record models.MFAChallenge
+	var sess *mongo.Session
+	var res *mongo.DeleteResult
+	var err error
+
+	log.Printf("[HANDLER]: loading login challenge from workspace under key %q", mfaChallengeRecordKey)
+	if err = space.Get(mfaChallengeRecordKey, &record); err != nil {
+		log.Printf("[HANDLER]: error loading login challenge (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation")
+	res, err = sess.Client().
+		Database(models.MFAChallengeQueryContext.Database).
+		Collection(models.MFAChallengeQueryContext.Collection).
+		DeleteOne(ctx, bson.D{{Key: "_id", Value: record.ID}})
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database removal (%s)", err.Error())
+		return err
+	}
+
+	if res.DeletedCount != 1 {
+		log.Printf("[HANDLER]: login challenge was already completed")
+		return ErrInvalidMFAChallenge
+	}
+	return nil
+}
+
+// Function `updateAccountMFA` applies an update to the two-factor authentication settings of an active account
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - filter: the filter selecting the account (extended to active accounts only)
+//   - update: the update to apply
+//   - unmatched: the error to report when no account matches the filter
+//
+// Returns:
+//   - `error`: issue performing the update (nil if no issue occurred)
+func updateAccountMFA(ctx context.Context, filter bson.D, update bson.D, unmatched error) error {
+	log.Printf("[HANDLER]: loading database session from request context...")
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err := sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		UpdateOne(ctx, append(filter, bson.E{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}), update)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: no account matched the update (%s)", unmatched.Error())
+		return unmatched
+	}
+	return nil
+}
+
+// Function `ensureMFAChallengeIndexes` creates the TTL index removing login challenges once they expire
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the index (nil if no issue occurred)
+func ensureMFAChallengeIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.MFAChallengeQueryContext.Database).
+		Collection(models.MFAChallengeQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "not_valid_after", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		})
+	return err
+}
+
+// Function `newTOTPSecret` generates a random authenticator secret
+//
+// Returns:
+//   - `string`: the base32 encoded secret
+func newTOTPSecret() string {
+	key := make([]byte, totpSecretSize)
+	rand.Read(key)
+	return totpEncoding.EncodeToString(key)
+}
+
+// Function `totpURI` creates the `otpauth://` URI registering the given secret with an authenticator app
+//
+// Parameters:
+//   - account: the account name shown by the authenticator app
+//   - secret: the base32 encoded secret
+//
+// Returns:
+//   - `string`: the URI
+func totpURI(account string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", totpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + url.PathEscape(totpIssuer+":"+account) + "?" + query.Encode()
+}
+
+// Function `totpCode` computes the TOTP code of the given key for the given time step (RFC 6238 with HMAC-SHA1)
+//
+// Parameters:
+//   - key: the decoded secret
+//   - step: the number of periods elapsed since the unix epoch
+//
+// Returns:
+//   - `string`: the zero-padded code
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulus := uint32(1)
+	for range totpDigits {
+		modulus *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulus)
+}
+
+// Function `matchTOTP` determines whether the given code is valid for the given secret around the given time
+//
+// Codes of the adjacent time steps are accepted to tolerate clock drift, but never those at or before the last accepted step.
+//
+// Parameters:
+//   - secret: the base32 encoded secret
+//   - code: the presented code
+//   - at: the time the code was presented
+//   - lastStep: the time step of the last accepted code
+//
+// Returns:
+//   - `int64`: the time step the code matched
+//   - `bool`: whether the code matched
+func matchTOTP(secret string, code string, at time.Time, lastStep int64) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := at.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// Function `hashRecoveryCode` hashes a recovery code for storage and comparison (case and dashes are ignored)
+//
+// Parameters:
+//   - code: the recovery code
+//
+// Returns:
+//   - `string`: the hex encoded hash
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(normalized)))
+}
+
+// Function `isMFAError` determines if the given error is related to two-factor authentication
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isMFAError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrMFAAlreadyEnabled), errors.Is(e, ErrMFANotEnabled), errors.Is(e, ErrMFANotEnrolled):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("mfa", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidMFAChallenge):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("challengeToken", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidMFACode):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("code", e.Error()),
+		), true
+	}
+	return nil, false
+}
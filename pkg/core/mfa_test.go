@@ -0,0 +1,352 @@
+package core
+
+/*
+ * File: pkg/core/mfa_test.go
+ *
+ * Purpose: unit tests for TOTP based two-factor authentication
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func findMFAUserOk(mfa bson.M) bson.D {
+	return bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.users"},
+			{Key: "firstBatch", Value: bson.A{
+				bson.M{
+					"_id":           bson.NewObjectID(),
+					"login_email":   "testuser@example.io",
+					"password_hash": "$argon2id$v=19$m=16,t=2,p=1$YWJjZGVmZ2g$njexcOQ6BRt+mtozS/6LDg",
+					"mfa":           mfa,
+					"metadata": bson.M{
+						"active":     true,
+						"created_at": time.Now().UTC(),
+						"updated_at": time.Now().UTC(),
+					},
+				},
+			}},
+		}},
+	}
+}
+
+func enabledMFA() bson.M {
+	return bson.M{
+		"enabled":        true,
+		"secret":         testTOTPSecret,
+		"recovery_codes": bson.A{hashRecoveryCode("ABCDEFGHIJKLMNOP")},
+		"last_step":      int64(0),
+		"enabled_at":     time.Now().UTC(),
+	}
+}
+
+func currentTOTPCode(t *testing.T) string {
+	t.Helper()
+	key, err := totpEncoding.DecodeString(testTOTPSecret)
+	require.NoError(t, err)
+	return totpCode(key, time.Now().Unix()/totpPeriod)
+}
+
+var findMFAChallengeOk = bson.D{
+	{Key: "ok", Value: 1},
+	{Key: "cursor", Value: bson.D{
+		{Key: "id", Value: int64(0)},
+		{Key: "ns", Value: "tournabyte.mfa_challenges"},
+		{Key: "firstBatch", Value: bson.A{
+			bson.M{
+				"_id":             "abc123",
+				"authorizes":      bson.NewObjectID(),
+				"not_valid_after": time.Now().UTC().Add(time.Minute),
+				"created_at":      time.Now().UTC(),
+			},
+		}},
+	}},
+}
+
+func TestTOTP(t *testing.T) {
+	t.Run("ReferenceCode", func(t *testing.T) {
+		assert.Equal(t, "287082", totpCode([]byte("12345678901234567890"), 59/totpPeriod))
+		assert.Equal(t, "081804", totpCode([]byte("12345678901234567890"), 1111111109/totpPeriod))
+	})
+
+	t.Run("AdjacentStepsAccepted", func(t *testing.T) {
+		key, err := totpEncoding.DecodeString(testTOTPSecret)
+		require.NoError(t, err)
+		at := time.Unix(1111111109, 0)
+
+		step, ok := matchTOTP(testTOTPSecret, totpCode(key, at.Unix()/totpPeriod-1), at, 0)
+		assert.True(t, ok)
+		assert.Equal(t, at.Unix()/totpPeriod-1, step)
+
+		_, ok = matchTOTP(testTOTPSecret, totpCode(key, at.Unix()/totpPeriod+2), at, 0)
+		assert.False(t, ok)
+	})
+
+	t.Run("ReplayRefused", func(t *testing.T) {
+		key, err := totpEncoding.DecodeString(testTOTPSecret)
+		require.NoError(t, err)
+		at := time.Unix(1111111109, 0)
+		code := totpCode(key, at.Unix()/totpPeriod)
+
+		step, ok := matchTOTP(testTOTPSecret, code, at, 0)
+		require.True(t, ok)
+
+		_, ok = matchTOTP(testTOTPSecret, code, at, step)
+		assert.False(t, ok)
+	})
+
+	t.Run("EnrollmentURI", func(t *testing.T) {
+		uri := totpURI("testuser@example.io", testTOTPSecret)
+
+		assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Tournabyte:testuser@example.io?"))
+		assert.Contains(t, uri, "secret="+testTOTPSecret)
+		assert.Contains(t, uri, "issuer=Tournabyte")
+	})
+}
+
+func TestMFAEnrollmentPipeline(t *testing.T) {
+	t.Run("SecretIssued", func(t *testing.T) {
+		var result models.MFAEnrollment
+		pCtx, pCancel, pIn, pOut := mfaEnrollmentPipeline(setupWorkingAdminContext(t, findUserOk, updateOneOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(mfaResponseKey, &result))
+
+		assert.NotZero(t, result.Secret)
+		assert.Contains(t, result.URI, "secret="+result.Secret)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("AlreadyEnabledRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := mfaEnrollmentPipeline(setupWorkingAdminContext(t, findMFAUserOk(enabledMFA())))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrMFAAlreadyEnabled)
+	})
+}
+
+func TestMFAConfirmationPipeline(t *testing.T) {
+	pending := bson.M{"enabled": false, "pending_secret": testTOTPSecret}
+
+	t.Run("RecoveryCodesIssued", func(t *testing.T) {
+		var result models.MFARecoveryCodes
+		pCtx, pCancel, pIn, pOut := mfaConfirmationPipeline(setupWorkingAdminContext(t, findMFAUserOk(pending), updateOneOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+		bindBodyTo(space, models.MFACodeRequest{Code: currentTOTPCode(t)})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(mfaResponseKey, &result))
+
+		assert.Len(t, result.RecoveryCodes, recoveryCodeCount)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("WrongCodeRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := mfaConfirmationPipeline(setupWorkingAdminContext(t, findMFAUserOk(pending)))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+		bindBodyTo(space, models.MFACodeRequest{Code: "12345"})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidMFACode)
+	})
+
+	t.Run("NotEnrolledRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := mfaConfirmationPipeline(setupWorkingAdminContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+		bindBodyTo(space, models.MFACodeRequest{Code: currentTOTPCode(t)})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrMFANotEnrolled)
+	})
+}
+
+func TestMFARemovalPipeline(t *testing.T) {
+	pCtx, pCancel, pIn, pOut := mfaRemovalPipeline(setupWorkingAdminContext(t, findMFAUserOk(enabledMFA()), updateOneOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+	bindBodyTo(space, models.MFACodeRequest{Code: currentTOTPCode(t)})
+	pIn <- space
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	assert.NoError(t, after.Get(mfaResponseKey, new(gin.H)))
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestUserAuthenticationChallenged(t *testing.T) {
+	var result models.MFAChallengeResponse
+	pCtx, pCancel, pIn, pOut := userAuthenticationPipeline(setupWorkingAdminContext(t, findMFAUserOk(enabledMFA()), insertOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	space := setupWorkingUserCreationWorkspace(t)
+	space.Set(mfaOptionsKey, models.MFAOptions{ChallengeExpiresIn: time.Minute})
+	pIn <- space
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	require.NoError(t, after.Get(userAuthorizationResponseKey, &result))
+
+	assert.True(t, result.MFARequired)
+	assert.NotZero(t, result.ChallengeToken)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), result.ExpiresAt, 5*time.Second)
+	assert.ErrorIs(t, after.Get(accessTokenKey, new(string)), handlerutil.ErrKeyNotExists)
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestMFAVerificationPipeline(t *testing.T) {
+	t.Run("AuthenticatorCodeAccepted", func(t *testing.T) {
+		var result models.AuthenticatedUser
+		pCtx, pCancel, pIn, pOut := mfaVerificationPipeline(setupWorkingAdminContext(t, findMFAChallengeOk, findMFAUserOk(enabledMFA()), updateOneOk, deleteOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingUserCreationWorkspace(t)
+		bindBodyTo(space, models.MFAVerificationRequest{ChallengeToken: "abc123", Code: currentTOTPCode(t)})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userAuthorizationResponseKey, &result))
+
+		assert.NotZero(t, result.AccessToken)
+		assert.NotZero(t, result.RefreshToken)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("RecoveryCodeAccepted", func(t *testing.T) {
+		var result models.AuthenticatedUser
+		pCtx, pCancel, pIn, pOut := mfaVerificationPipeline(setupWorkingAdminContext(t, findMFAChallengeOk, findMFAUserOk(enabledMFA()), updateOneOk, deleteOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingUserCreationWorkspace(t)
+		bindBodyTo(space, models.MFAVerificationRequest{ChallengeToken: "abc123", Code: "abcd-efgh-ijkl-mnop"})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userAuthorizationResponseKey, &result))
+
+		assert.NotZero(t, result.AccessToken)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("WrongCodeRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := mfaVerificationPipeline(setupWorkingAdminContext(t, findMFAChallengeOk, findMFAUserOk(enabledMFA())))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingUserCreationWorkspace(t)
+		bindBodyTo(space, models.MFAVerificationRequest{ChallengeToken: "abc123", Code: "000000"})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		require.ErrorIs(t, context.Cause(pCtx), ErrInvalidMFACode)
+
+		_, matched := isMFAError(context.Cause(pCtx))
+		assert.True(t, matched)
+	})
+
+	t.Run("UnknownChallengeRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := mfaVerificationPipeline(setupWorkingAdminContext(t, findLoginAttemptsOk()))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingUserCreationWorkspace(t)
+		bindBodyTo(space, models.MFAVerificationRequest{ChallengeToken: "abc123", Code: currentTOTPCode(t)})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidMFAChallenge)
+	})
+}
@@ -14,6 +14,7 @@ package core
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -111,3 +112,16 @@ func (srv *tournabyteAPIService) withMongoTransaction(ctx *gin.Context) {
 		}
 	}
 }
+
+// Function `(*tournabyteAPIService).withoutAPIKeys` refuses requests presenting an API key in place of an access token
+//
+// Parameters:
+//   - ctx: the context of a request reserved for logged in users (i.e. account management)
+func (srv *tournabyteAPIService) withoutAPIKeys(ctx *gin.Context) {
+	if strings.HasPrefix(ctx.GetHeader("Authorization"), apiKeyPrefix) {
+		log.Printf("[MIDDLEWARE]: API key presented for an operation requiring a login, refusing request")
+		handlerutil.RespondWithError(ctx, srv.errfmt.Format(ErrAPIKeyNotAccepted))
+		return
+	}
+	ctx.Next()
+}
@@ -0,0 +1,897 @@
+package core
+
+/*
+ * File: pkg/core/oidc.go
+ *
+ * Purpose: logging in through external OpenID Connect providers (authorization code flow with PKCE)
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Duration a login through an external provider remains valid after it was started
+const oidcLoginTTL = 10 * time.Minute
+
+// Duration to wait on an external provider before giving up
+const oidcRequestTimeout = 10 * time.Second
+
+// Clock skew tolerated when validating ID tokens issued by an external provider
+const oidcClockSkew = time.Minute
+
+// Scopes requested from an external provider when none are configured
+var defaultOIDCScopes = []string{"email"}
+
+// Signature algorithms accepted for ID tokens issued by an external provider
+var oidcAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.PS256, jose.ES256, jose.EdDSA}
+
+// Workspace keys associated with external login workspace tasks
+const (
+	identityProvidersKey         = "identityProviders"
+	identityProviderKey          = "identityProvider"
+	oidcCallbackRequestKey       = "oidcCallbackRequest"
+	oidcLoginRecordKey           = "oidcLoginRecord"
+	oidcIdentityKey              = "oidcIdentity"
+	oidcAuthorizationResponseKey = "oidcAuthorization"
+)
+
+// Errors specific to external login workflow tasks
+var (
+	ErrUnknownIdentityProvider = errors.New("no such identity provider is configured")
+	ErrIdentityProviderFailure = errors.New("identity provider could not complete the login")
+	ErrInvalidOIDCState        = errors.New("login state is invalid or expired")
+	ErrInvalidIDToken          = errors.New("identity provider issued an invalid ID token")
+	ErrExternalEmailInUse      = errors.New("email of the external identity belongs to another account (sign in with its password and verify the email before logging in with this provider)")
+)
+
+// Type `oidcDiscovery` represents the parts of an OpenID Connect discovery document used to log in
+//
+// Members:
+//   - Issuer: the issuer URL the provider identifies as
+//   - AuthorizationEndpoint: the URL users are sent to for authorizing a login
+//   - TokenEndpoint: the URL authorization codes are exchanged at
+//   - JWKSURI: the URL the keys verifying ID tokens are published at
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Type `oidcIdentityClaims` represents the ID token claims identifying the user beyond the registered claims
+//
+// Members:
+//   - Nonce: the nonce of the login the token was issued for
+//   - Email: the email of the user at the provider
+//   - EmailVerified: indicates the provider verified the email of the user
+type oidcIdentityClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+// Type `oidcProvider` represents an external OpenID Connect provider users can log in with
+//
+// Members:
+//   - name: the name identifying the provider in request paths
+//   - issuer: the issuer URL of the provider
+//   - clientID: the client ID registered with the provider
+//   - clientSecret: the client secret registered with the provider (empty for public clients)
+//   - redirectURL: the URL the provider returns the user to after authorizing
+//   - scopes: the scopes requested in addition to `openid`
+//   - client: the HTTP client used to reach the provider
+//   - mu: guards the cached discovery document and keys
+//   - discovery: the discovery document of the provider (nil until first needed)
+//   - keys: the keys verifying ID tokens of the provider (nil until first needed)
+type oidcProvider struct {
+	name         string
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	client       *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      *jose.JSONWebKeySet
+}
+
+// Function `newOIDCProvider` creates an external provider from its configuration
+//
+// Parameters:
+//   - opts: the configuration of the provider
+//
+// Returns:
+//   - `*oidcProvider`: the provider (its discovery document is fetched when first needed)
+func newOIDCProvider(opts models.IdentityProviderOptions) *oidcProvider {
+	scopes := opts.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+
+	return &oidcProvider{
+		name:         opts.Name,
+		issuer:       strings.TrimSuffix(opts.Issuer, "/"),
+		clientID:     opts.ClientID,
+		clientSecret: opts.ClientSecret,
+		redirectURL:  opts.RedirectURL,
+		scopes:       scopes,
+		client:       &http.Client{Timeout: oidcRequestTimeout},
+	}
+}
+
+// Function `identityProvidersFromConfig` creates the external providers configured by the given application configuration
+//
+// Parameters:
+//   - cfg: the application configuration
+//
+// Returns:
+//   - `map[string]*oidcProvider`: the providers by name
+func identityProvidersFromConfig(cfg *models.ApplicationOptions) map[string]*oidcProvider {
+	providers := make(map[string]*oidcProvider, len(cfg.Serve.IdentityProviders))
+	for _, opts := range cfg.Serve.IdentityProviders {
+		providers[opts.Name] = newOIDCProvider(opts)
+	}
+	return providers
+}
+
+// Function `(*oidcProvider).Discover` retrieves the discovery document of the provider (cached after the first success)
+//
+// Parameters:
+//   - ctx: the context bounding the request to the provider
+//
+// Returns:
+//   - `oidcDiscovery`: the discovery document
+//   - `error`: issue retrieving the document (nil if no issue occurred)
+func (p *oidcProvider) Discover(ctx context.Context) (oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return *p.discovery, nil
+	}
+
+	var doc oidcDiscovery
+	if err := p.getJSON(ctx, p.issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return doc, err
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.issuer {
+		return doc, fmt.Errorf("%w: discovery document issued by %q", ErrIdentityProviderFailure, doc.Issuer)
+	}
+
+	p.discovery = &doc
+	return doc, nil
+}
+
+// Function `(*oidcProvider).AuthorizationURL` creates the URL sending a user to the provider for authorizing a login
+//
+// Parameters:
+//   - ctx: the context bounding the request to the provider
+//   - state: the value the provider returns along with the authorization code
+//   - nonce: the value the ID token issued for the login must contain
+//   - verifier: the PKCE code verifier of the login (only its S256 challenge is sent)
+//
+// Returns:
+//   - `string`: the authorization URL
+//   - `error`: issue retrieving the discovery document of the provider (nil if no issue occurred)
+func (p *oidcProvider) AuthorizationURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	doc, err := p.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	challenge := sha256.Sum256([]byte(verifier))
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.clientID)
+	query.Set("redirect_uri", p.redirectURL)
+	query.Set("scope", strings.Join(append([]string{"openid"}, p.scopes...), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return doc.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Function `(*oidcProvider).Exchange` exchanges an authorization code for the ID token of the user
+//
+// Parameters:
+//   - ctx: the context bounding the request to the provider
+//   - code: the authorization code returned by the provider
+//   - verifier: the PKCE code verifier of the login
+//
+// Returns:
+//   - `string`: the raw ID token
+//   - `error`: issue exchanging the code (nil if no issue occurred)
+func (p *oidcProvider) Exchange(ctx context.Context, code string, verifier string) (string, error) {
+	var body struct {
+		IDToken string `json:"id_token"`
+	}
+
+	doc, err := p.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.redirectURL)
+	form.Set("client_id", p.clientID)
+	form.Set("code_verifier", verifier)
+	if p.clientSecret != "" {
+		form.Set("client_secret", p.clientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if err = p.do(req, &body); err != nil {
+		return "", err
+	}
+	if body.IDToken == "" {
+		return "", fmt.Errorf("%w: no ID token issued", ErrIdentityProviderFailure)
+	}
+	return body.IDToken, nil
+}
+
+// Function `(*oidcProvider).Verify` verifies an ID token issued by the provider for a login
+//
+// Parameters:
+//   - ctx: the context bounding the request to the provider
+//   - raw: the raw ID token
+//   - nonce: the nonce of the login the token must have been issued for
+//
+// Returns:
+//   - `models.ExternalIdentity`: the identity of the user the token was issued to
+//   - `bool`: whether the provider verified the email of the user
+//   - `error`: `ErrInvalidIDToken` if the token is not valid for the login (or another issue verifying it)
+func (p *oidcProvider) Verify(ctx context.Context, raw string, nonce string) (models.ExternalIdentity, bool, error) {
+	var registered jwt.Claims
+	var identity oidcIdentityClaims
+
+	token, err := jwt.ParseSigned(raw, oidcAlgorithms)
+	if err != nil {
+		return models.ExternalIdentity{}, false, fmt.Errorf("%w: %s", ErrInvalidIDToken, err.Error())
+	}
+
+	key, err := p.verificationKey(ctx, token.Headers[0].KeyID)
+	if err != nil {
+		return models.ExternalIdentity{}, false, err
+	}
+
+	if err = token.Claims(key, &registered, &identity); err != nil {
+		return models.ExternalIdentity{}, false, fmt.Errorf("%w: %s", ErrInvalidIDToken, err.Error())
+	}
+
+	expected := jwt.Expected{Issuer: p.issuer, AnyAudience: jwt.Audience{p.clientID}}
+	if err = registered.ValidateWithLeeway(expected, oidcClockSkew); err != nil {
+		return models.ExternalIdentity{}, false, fmt.Errorf("%w: %s", ErrInvalidIDToken, err.Error())
+	}
+	if registered.Subject == "" || subtle.ConstantTimeCompare([]byte(identity.Nonce), []byte(nonce)) != 1 {
+		return models.ExternalIdentity{}, false, fmt.Errorf("%w: subject or nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return models.ExternalIdentity{
+		Provider: p.name,
+		Subject:  registered.Subject,
+		Email:    strings.ToLower(identity.Email),
+		LinkedAt: time.Now().UTC(),
+	}, identity.EmailVerified, nil
+}
+
+// Function `(*oidcProvider).verificationKey` selects the published key of the provider with the given key ID
+//
+// The published keys are fetched again once when no key matches (the provider may have rotated its keys).
+//
+// Parameters:
+//   - ctx: the context bounding the request to the provider
+//   - kid: the key ID of the token to verify
+//
+// Returns:
+//   - `any`: the public key
+//   - `error`: `ErrInvalidIDToken` if no published key matches (or another issue fetching the keys)
+func (p *oidcProvider) verificationKey(ctx context.Context, kid string) (any, error) {
+	doc, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for refreshed := false; ; refreshed = true {
+		if p.keys != nil {
+			for _, key := range p.keys.Keys {
+				if (kid == "" || key.KeyID == kid) && key.Valid() && key.IsPublic() {
+					return key.Key, nil
+				}
+			}
+		}
+		if refreshed {
+			return nil, fmt.Errorf("%w: no published key matches (kid=%q)", ErrInvalidIDToken, kid)
+		}
+
+		var keys jose.JSONWebKeySet
+		if err = p.getJSON(ctx, doc.JWKSURI, &keys); err != nil {
+			return nil, err
+		}
+		p.keys = &keys
+	}
+}
+
+// Function `(*oidcProvider).getJSON` retrieves a JSON document from the provider
+//
+// Parameters:
+//   - ctx: the context bounding the request to the provider
+//   - target: the URL of the document
+//   - out: the value to decode the document into
+//
+// Returns:
+//   - `error`: issue retrieving the document (nil if no issue occurred)
+func (p *oidcProvider) getJSON(ctx context.Context, target string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	return p.do(req, out)
+}
+
+// Function `(*oidcProvider).do` sends a request to the provider and decodes its JSON response
+//
+// Parameters:
+//   - req: the request to send
+//   - out: the value to decode the response into
+//
+// Returns:
+//   - `error`: `ErrIdentityProviderFailure` if the provider is unreachable or refuses the request (nil if no issue occurred)
+func (p *oidcProvider) do(req *http.Request, out any) error {
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrIdentityProviderFailure, err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("%w: %s responded %d (%s)", ErrIdentityProviderFailure, req.URL.Path, res.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	if err = json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(out); err != nil {
+		return fmt.Errorf("%w: %s", ErrIdentityProviderFailure, err.Error())
+	}
+	return nil
+}
+
+// Function `(*tournabyteAPIService).initOIDCWorkspace` initializes the handler workspace for a login through an external provider
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for logging in through an external provider
+func (srv *tournabyteAPIService) initOIDCWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveURIValues)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(identityProvidersKey, srv.providers)
+	space.Set(authSessionOptionsKey, srv.getSessionConfig())
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(mfaOptionsKey, srv.getMFAConfig())
+	space.Set(requestClientKey, models.ClientDetails{UserAgent: ctx.Request.UserAgent(), IPAddress: ctx.ClientIP()})
+	log.Printf("[HANDLER]: setup request bindings and identity providers")
+	return &space
+}
+
+// Function `oidcAuthorizationPipeline` initializes a handling pipeline for starting a login through an external provider
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func oidcAuthorizationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, resolveIdentityProvider, pipelineInput)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createOIDCLoginRecord, out1)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `oidcCallbackPipeline` initializes a handling pipeline for completing a login through an external provider
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func oidcCallbackPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, resolveIdentityProvider, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindOIDCCallbackRequest, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, consumeOIDCLoginRecord, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, exchangeOIDCAuthorizationCode, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, linkExternalIdentity, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, challengeMFALogin, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(createAccessToken), out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(createRefreshToken), out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(deriveSessionRecord), out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(createSessionRecord), out9)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, unlessMFAChallenged(populateUserAuthorizationResponse), out10)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `resolveIdentityProvider` binds the request URI and saves the external provider it names to the handler workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrUnknownIdentityProvider` if no provider of that name is configured (or another error that occurred during this processing step)
+func resolveIdentityProvider(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.IdentityProviderLookup
+	var providers map[string]*oidcProvider
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request URI to variable of type %T...", lookup)
+	if err := bindings.BindURI(&lookup); err != nil {
+		log.Printf("[HANDLER]: error binding request URI (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading identity providers from workspace under %q key...", identityProvidersKey)
+	if err := space.Get(identityProvidersKey, &providers); err != nil {
+		log.Printf("[HANDLER]: error loading identity providers (%s)", err.Error())
+		return err
+	}
+
+	provider, ok := providers[lookup.Provider]
+	if !ok {
+		log.Printf("[HANDLER]: identity provider %q is not configured", lookup.Provider)
+		return ErrUnknownIdentityProvider
+	}
+
+	space.Set(identityProviderKey, provider)
+	log.Printf("[HANDLER]: saved identity provider %q within workspace under key %q", lookup.Provider, identityProviderKey)
+	return nil
+}
+
+// Function `bindOIDCCallbackRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the external login process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindOIDCCallbackRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.OIDCCallbackRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(oidcCallbackRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, oidcCallbackRequestKey)
+	return nil
+}
+
+// Function `createOIDCLoginRecord` starts a login through the external provider and stores its state, nonce and PKCE code verifier
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createOIDCLoginRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var provider *oidcProvider
+	var sess *mongo.Session
+	var cfg *options.InsertOneOptionsBuilder
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading identity provider from workspace under %q key...", identityProviderKey)
+	if err = space.Get(identityProviderKey, &provider); err != nil {
+		log.Printf("[HANDLER]: error loading identity provider (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating login state, nonce and code verifier...")
+	state := rand.Text()
+	record := models.OIDCLogin{
+		ID:            fmt.Sprintf("%x", sha256.Sum256([]byte(state))),
+		Provider:      provider.name,
+		Nonce:         rand.Text(),
+		Verifier:      rand.Text() + rand.Text(),
+		NotValidAfter: now.Add(oidcLoginTTL),
+		CreatedAt:     now,
+	}
+
+	log.Printf("[HANDLER]: building authorization URL of identity provider %q...", provider.name)
+	target, err := provider.AuthorizationURL(ctx, state, record.Nonce, record.Verifier)
+	if err != nil {
+		log.Printf("[HANDLER]: error building authorization URL (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation")
+	if _, err = sess.Client().
+		Database(models.OIDCLoginQueryContext.Database).
+		Collection(models.OIDCLoginQueryContext.Collection).
+		InsertOne(ctx, record, cfg); err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(oidcAuthorizationResponseKey, models.OIDCAuthorization{AuthorizationURL: target, State: state, ExpiresAt: record.NotValidAfter})
+	log.Printf("[HANDLER]: login through identity provider %q started (expires=%s)", provider.name, record.NotValidAfter)
+	return nil
+}
+
+// Function `consumeOIDCLoginRecord` removes the unexpired login matching the returned state so that it cannot be completed twice
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidOIDCState` if no unexpired login of the provider matches (or another error that occurred during this processing step)
+func consumeOIDCLoginRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var provider *oidcProvider
+	var req models.OIDCCallbackRequest
+	var record models.OIDCLogin
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading identity provider from workspace under %q key...", identityProviderKey)
+	if err = space.Get(identityProviderKey, &provider); err != nil {
+		log.Printf("[HANDLER]: error loading identity provider (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading callback request from workspace under key %q", oidcCallbackRequestKey)
+	if err = space.Get(oidcCallbackRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading callback request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation")
+	filter := bson.D{
+		{Key: "_id", Value: fmt.Sprintf("%x", sha256.Sum256([]byte(req.State)))},
+		{Key: "provider", Value: provider.name},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}
+	err = sess.Client().
+		Database(models.OIDCLoginQueryContext.Database).
+		Collection(models.OIDCLoginQueryContext.Collection).
+		FindOneAndDelete(ctx, filter).
+		Decode(&record)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no unexpired login matches the state presented")
+		return ErrInvalidOIDCState
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database removal (%s)", err.Error())
+		return err
+	}
+
+	space.Set(oidcLoginRecordKey, record)
+	log.Printf("[HANDLER]: saved login as variable of type %T within workspace under key %q", record, oidcLoginRecordKey)
+	return nil
+}
+
+// Function `exchangeOIDCAuthorizationCode` exchanges the returned authorization code and verifies the ID token the provider issues for it
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func exchangeOIDCAuthorizationCode(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var provider *oidcProvider
+	var req models.OIDCCallbackRequest
+	var record models.OIDCLogin
+	var err error
+
+	log.Printf("[HANDLER]: loading identity provider from workspace under %q key...", identityProviderKey)
+	if err = space.Get(identityProviderKey, &provider); err != nil {
+		log.Printf("[HANDLER]: error loading identity provider (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading callback request from workspace under key %q", oidcCallbackRequestKey)
+	if err = space.Get(oidcCallbackRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading callback request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading login from workspace under key %q", oidcLoginRecordKey)
+	if err = space.Get(oidcLoginRecordKey, &record); err != nil {
+		log.Printf("[HANDLER]: error loading login (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: exchanging authorization code with identity provider %q...", provider.name)
+	raw, err := provider.Exchange(ctx, req.Code, record.Verifier)
+	if err != nil {
+		log.Printf("[HANDLER]: error exchanging authorization code (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: verifying ID token...")
+	identity, verified, err := provider.Verify(ctx, raw, record.Nonce)
+	if err != nil {
+		log.Printf("[HANDLER]: error verifying ID token (%s)", err.Error())
+		return err
+	}
+
+	if !verified {
+		identity.Email = ""
+	}
+
+	space.Set(oidcIdentityKey, identity)
+	log.Printf("[HANDLER]: external identity (provider=%q, subject=%q) verified", identity.Provider, identity.Subject)
+	return nil
+}
+
+// Function `linkExternalIdentity` finds the account the verified external identity is linked to, linking or creating one on first login
+//
+// Identities whose email the provider did not verify are refused. Otherwise the identity is linked to the active account with the same login
+// email, provided the account owner verified that email as well; an unverified account could have been registered by someone else with the
+// victim's address, so linking it would hand the account (and the password its creator chose) to the wrong person. When no such account exists a
+// new account is created for the identity, which is refused when an unverified active account already uses the email.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidIDToken` if no verified email was reported, `ErrExternalEmailInUse` if the account cannot be created (or another error that
+//     occurred during this processing step)
+func linkExternalIdentity(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var identity models.ExternalIdentity
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading external identity from workspace under key %q", oidcIdentityKey)
+	if err = space.Get(oidcIdentityKey, &identity); err != nil {
+		log.Printf("[HANDLER]: error loading external identity (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+	users := sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection)
+	active := bson.E{Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}
+
+	log.Printf("[HANDLER]: looking up account linked to the external identity...")
+	filter := bson.D{
+		{Key: "identities", Value: bson.D{{Key: "$elemMatch", Value: bson.D{{Key: "provider", Value: identity.Provider}, {Key: "subject", Value: identity.Subject}}}}},
+		active,
+	}
+	if err = users.FindOne(ctx, filter).Decode(&acct); err == nil {
+		space.Set(userAccountRecordKey, acct)
+		log.Printf("[HANDLER]: external identity is linked to account (_id=%q)", acct.ID.Hex())
+		return nil
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	if identity.Email == "" {
+		log.Printf("[HANDLER]: identity provider did not report a verified email, cannot create an account")
+		return fmt.Errorf("%w: no verified email reported", ErrInvalidIDToken)
+	}
+
+	log.Printf("[HANDLER]: linking external identity to the verified account with the same email (if any)...")
+	cfg := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	update := bson.D{
+		{Key: "$push", Value: bson.D{{Key: "identities", Value: identity}}},
+		{Key: "$set", Value: bson.D{{Key: "metadata.updated_at", Value: identity.LinkedAt}}},
+	}
+	filter = bson.D{{Key: "login_email", Value: identity.Email}, {Key: "email_verified", Value: true}, active}
+	if err = users.FindOneAndUpdate(ctx, filter, update, cfg).Decode(&acct); err == nil {
+		space.Set(userAccountRecordKey, acct)
+		log.Printf("[HANDLER]: external identity linked to account (_id=%q)", acct.ID.Hex())
+		return nil
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: error performing database update (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking whether an unverified account already uses the email...")
+	filter = bson.D{{Key: "login_email", Value: identity.Email}, active}
+	if err = users.FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).Err(); err == nil {
+		log.Printf("[HANDLER]: email of the external identity belongs to an unverified account")
+		return ErrExternalEmailInUse
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: creating account for the external identity...")
+	acct = models.UserAccount{
+		ID:            bson.NewObjectID(),
+		LoginEmail:    identity.Email,
+		EmailVerified: true,
+		Identities:    []models.ExternalIdentity{identity},
+		Metadata:      dbx.InitialMetadata(),
+	}
+	if _, err = users.InsertOne(ctx, acct); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			log.Printf("[HANDLER]: email of the external identity was taken by a concurrent registration")
+			return ErrExternalEmailInUse
+		}
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(userAccountRecordKey, acct)
+	log.Printf("[HANDLER]: account (_id=%q) created for the external identity", acct.ID.Hex())
+	return nil
+}
+
+// Function `ensureOIDCIndexes` creates the index looking up accounts by linked identity and the TTL index removing logins once they expire
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the indexes (nil if no issue occurred)
+func ensureOIDCIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.OIDCLoginQueryContext.Database).
+		Collection(models.OIDCLoginQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "not_valid_after", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "identities.provider", Value: 1}, {Key: "identities.subject", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.D{{Key: "identities", Value: bson.D{{Key: "$exists", Value: true}}}}),
+		})
+	return err
+}
+
+// Function `isOIDCError` determines if the given error is a failed login through an external provider
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isOIDCError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrUnknownIdentityProvider):
+		return handlerutil.ErrBadRequest(
+			handlerutil.NewDetail("provider", e.Error()),
+		), true
+	case errors.Is(e, ErrIdentityProviderFailure):
+		return handlerutil.ErrUpstreamUnreachable(
+			handlerutil.NewDetail("provider", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidOIDCState):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("state", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidIDToken):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("idToken", e.Error()),
+		), true
+	case errors.Is(e, ErrExternalEmailInUse):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("email", e.Error()),
+		), true
+	}
+	return nil, false
+}
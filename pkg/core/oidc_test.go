@@ -0,0 +1,364 @@
+package core
+
+/*
+ * File: pkg/core/oidc_test.go
+ *
+ * Purpose: unit tests for logging in through external OpenID Connect providers
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	testOIDCClientID = "tournabyte-test"
+	testOIDCNonce    = "n0nc3"
+	testOIDCVerifier = "v3r1f13r"
+	testOIDCCode     = "authcode"
+)
+
+// Type `mockOIDCProvider` is a local OpenID Connect provider issuing ID tokens for a single authorization code
+type mockOIDCProvider struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	nonce    string
+	email    string
+	verified bool
+}
+
+func setupMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mock := &mockOIDCProvider{key: key, nonce: testOIDCNonce, email: "Player@Example.io", verified: true}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 mock.URL,
+			"authorization_endpoint": mock.URL + "/authorize",
+			"token_endpoint":         mock.URL + "/token",
+			"jwks_uri":               mock.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &mock.key.PublicKey, KeyID: "mock", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != testOIDCCode || r.PostFormValue("code_verifier") != testOIDCVerifier || r.PostFormValue("client_id") != testOIDCClientID {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": mock.idToken(t)})
+	})
+	mock.Server = httptest.NewServer(mux)
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func (m *mockOIDCProvider) idToken(t *testing.T) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: m.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "mock"),
+	)
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).
+		Claims(jwt.Claims{
+			Issuer:   m.URL,
+			Subject:  "mock-subject",
+			Audience: jwt.Audience{testOIDCClientID},
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}).
+		Claims(oidcIdentityClaims{Nonce: m.nonce, Email: m.email, EmailVerified: m.verified}).
+		Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func setupWorkingOIDCWorkspace(t *testing.T, mock *mockOIDCProvider, body any) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := setupWorkingUserCreationWorkspace(t)
+
+	var bindings handlerutil.Bindings
+	_ = space.Get(handlerutil.RequestBindings, &bindings)
+	bindings.URI = func(a any) error {
+		lookup, ok := a.(*models.IdentityProviderLookup)
+		if !ok {
+			return handlerutil.ErrNotAssignable
+		}
+		lookup.Provider = "mock"
+		return nil
+	}
+	space.Set(handlerutil.RequestBindings, bindings)
+	if body != nil {
+		bindBodyTo(space, body)
+	}
+
+	space.Set(identityProvidersKey, map[string]*oidcProvider{
+		"mock": newOIDCProvider(models.IdentityProviderOptions{
+			Name:        "mock",
+			Issuer:      mock.URL,
+			ClientID:    testOIDCClientID,
+			RedirectURL: "https://tournabyte.example/login/mock",
+		}),
+	})
+	space.Set(requestClientKey, models.ClientDetails{UserAgent: "go-test", IPAddress: "127.0.0.1"})
+
+	return space
+}
+
+var (
+	consumeOIDCLoginOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 1}}},
+		{Key: "value", Value: bson.M{
+			"_id":             "abc123",
+			"provider":        "mock",
+			"nonce":           testOIDCNonce,
+			"verifier":        testOIDCVerifier,
+			"not_valid_after": time.Now().UTC().Add(time.Minute),
+			"created_at":      time.Now().UTC(),
+		}},
+	}
+	noDocumentModified = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 0}}},
+		{Key: "value", Value: nil},
+	}
+	insertDuplicateKey = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "n", Value: 0},
+		{Key: "writeErrors", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "code", Value: 11000}, {Key: "errmsg", Value: "E11000 duplicate key error"}}}},
+	}
+	findLinkedUserOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.users"},
+			{Key: "firstBatch", Value: bson.A{
+				bson.M{
+					"_id":            bson.NewObjectID(),
+					"login_email":    "player@example.io",
+					"email_verified": true,
+					"identities":     bson.A{bson.M{"provider": "mock", "subject": "mock-subject", "email": "player@example.io", "linked_at": time.Now().UTC()}},
+					"metadata": bson.M{
+						"active":     true,
+						"created_at": time.Now().UTC(),
+						"updated_at": time.Now().UTC(),
+					},
+				},
+			}},
+		}},
+	}
+)
+
+func TestOIDCAuthorizationPipeline(t *testing.T) {
+	var result models.OIDCAuthorization
+	mock := setupMockOIDCProvider(t)
+	pCtx, pCancel, pIn, pOut := oidcAuthorizationPipeline(setupWorkingAdminContext(t, insertOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	pIn <- setupWorkingOIDCWorkspace(t, mock, nil)
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	require.NoError(t, after.Get(oidcAuthorizationResponseKey, &result))
+
+	target, err := url.Parse(result.AuthorizationURL)
+	require.NoError(t, err)
+	query := target.Query()
+
+	assert.Equal(t, mock.URL+"/authorize", target.Scheme+"://"+target.Host+target.Path)
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, testOIDCClientID, query.Get("client_id"))
+	assert.Equal(t, "openid email", query.Get("scope"))
+	assert.Equal(t, result.State, query.Get("state"))
+	assert.NotEmpty(t, query.Get("nonce"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Len(t, query.Get("code_challenge"), base64.RawURLEncoding.EncodedLen(sha256.Size))
+	assert.WithinDuration(t, time.Now().Add(oidcLoginTTL), result.ExpiresAt, 5*time.Second)
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestOIDCCallbackPipeline(t *testing.T) {
+	callback := models.OIDCCallbackRequest{State: "abc123", Code: testOIDCCode}
+
+	t.Run("AccountCreated", func(t *testing.T) {
+		var result models.AuthenticatedUser
+		var acct models.UserAccount
+		mock := setupMockOIDCProvider(t)
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk, findNoUserOk, noDocumentModified, findNoUserOk, insertOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userAuthorizationResponseKey, &result))
+		require.NoError(t, after.Get(userAccountRecordKey, &acct))
+
+		assert.NotZero(t, result.AccessToken)
+		assert.NotZero(t, result.RefreshToken)
+		assert.Equal(t, "player@example.io", acct.LoginEmail)
+		assert.Empty(t, acct.PasswordHash)
+		assert.True(t, acct.EmailVerified)
+		require.Len(t, acct.Identities, 1)
+		assert.Equal(t, "mock", acct.Identities[0].Provider)
+		assert.Equal(t, "mock-subject", acct.Identities[0].Subject)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("LinkedIdentityLogsIn", func(t *testing.T) {
+		var result models.AuthenticatedUser
+		mock := setupMockOIDCProvider(t)
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk, findLinkedUserOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userAuthorizationResponseKey, &result))
+		assert.NotZero(t, result.AccessToken)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UnknownStateRejected", func(t *testing.T) {
+		mock := setupMockOIDCProvider(t)
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, noDocumentModified))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidOIDCState)
+	})
+
+	t.Run("NonceMismatchRejected", func(t *testing.T) {
+		mock := setupMockOIDCProvider(t)
+		mock.nonce = "replayed"
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidIDToken)
+	})
+
+	t.Run("RejectedCodeIsUpstreamFailure", func(t *testing.T) {
+		mock := setupMockOIDCProvider(t)
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, models.OIDCCallbackRequest{State: "abc123", Code: "forged"})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrIdentityProviderFailure)
+	})
+
+	t.Run("UnverifiedEmailNotLinked", func(t *testing.T) {
+		mock := setupMockOIDCProvider(t)
+		mock.verified = false
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk, findNoUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidIDToken)
+	})
+
+	t.Run("EmailOfUnverifiedAccount", func(t *testing.T) {
+		mock := setupMockOIDCProvider(t)
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk, findNoUserOk, noDocumentModified, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrExternalEmailInUse)
+	})
+
+	t.Run("EmailTakenConcurrently", func(t *testing.T) {
+		mock := setupMockOIDCProvider(t)
+		pCtx, pCancel, pIn, pOut := oidcCallbackPipeline(setupWorkingAdminContext(t, consumeOIDCLoginOk, findNoUserOk, noDocumentModified, findNoUserOk, insertDuplicateKey))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingOIDCWorkspace(t, mock, callback)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrExternalEmailInUse)
+	})
+}
@@ -0,0 +1,752 @@
+package core
+
+/*
+ * File: pkg/core/password.go
+ *
+ * Purpose: password change and password reset logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"github.com/tournabyte/webapi/pkg/notify"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Duration a password reset token remains valid when not configured
+const defaultPasswordResetTTL = 30 * time.Minute
+
+// Workspace keys associated with password change and password reset workspace tasks
+const (
+	passwordChangeRequestKey   = "passwordChangeRequest"
+	passwordResetRequestKey    = "passwordResetRequest"
+	passwordResetOptionsKey    = "passwordResetOptions"
+	passwordResetTokenKey      = "passwordResetToken"
+	passwordResetResponseKey   = "passwordResetResponse"
+	pendingPasswordKey         = "pendingPassword"
+	passwordResetRedemptionKey = "passwordResetRedemption"
+)
+
+// Errors specific to password change and password reset workflow tasks
+var (
+	ErrIncorrectPassword         = errors.New("current password is incorrect")
+	ErrInvalidPasswordResetToken = errors.New("password reset token is invalid or expired")
+)
+
+// Function `(*tournabyteAPIService).initPasswordChangeWorkspace` initializes the handler workspace for a password change request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for changing the password of the current user
+func (srv *tournabyteAPIService) initPasswordChangeWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
+// Function `(*tournabyteAPIService).initPasswordResetWorkspace` initializes the handler workspace for a password reset request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for requesting or redeeming a password reset
+func (srv *tournabyteAPIService) initPasswordResetWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(passwordResetOptionsKey, srv.getPasswordResetConfig())
+	log.Printf("[HANDLER]: setup request bindings and password reset configurations")
+	return &space
+}
+
+// Function `passwordChangePipeline` initializes a handling pipeline for changing the password of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func passwordChangePipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindPasswordChangeRequest, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyCurrentPassword, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyPendingPassword, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, discardPasswordResetTokens, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeSessionsOfUser, out7)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(activeUserTokenScope), out8)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `passwordResetRequestPipeline` initializes a handling pipeline for issuing and delivering a password reset token
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func passwordResetRequestPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindPasswordResetRequest, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchResettableAccountByEmail, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, createPasswordResetToken, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, deliverPasswordResetToken, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, confirmPasswordResetRequest, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `passwordResetRedemptionPipeline` initializes a handling pipeline for exchanging a password reset token for a new password
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func passwordResetRedemptionPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindPasswordResetRedemption, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, consumePasswordResetToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, applyPendingPassword, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, discardPasswordResetTokens, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeSessionsOfUser, out4)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(activeUserTokenScope), out5)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindPasswordChangeRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the password change process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindPasswordChangeRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.PasswordChangeRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(passwordChangeRequestKey, body)
+	space.Set(pendingPasswordKey, body.NewPassword)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, passwordChangeRequestKey)
+	return nil
+}
+
+// Function `bindPasswordResetRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the password reset process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindPasswordResetRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.PasswordResetRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(passwordResetRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, passwordResetRequestKey)
+	return nil
+}
+
+// Function `bindPasswordResetRedemption` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the password reset process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindPasswordResetRedemption(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.PasswordResetRedemption
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(passwordResetRedemptionKey, body)
+	space.Set(pendingPasswordKey, body.NewPassword)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, passwordResetRedemptionKey)
+	return nil
+}
+
+// Function `fetchAccountRecordOfActiveUser` retrieves the account record of the user presenting the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchAccountRecordOfActiveUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, filter).
+		Decode(&acct)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	space.Set(userAccountRecordKey, acct)
+	return nil
+}
+
+// Function `verifyCurrentPassword` compares the current password presented in the password change request with the stored password hash
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrIncorrectPassword` if the password does not match (or another error that occurred during this processing step)
+func verifyCurrentPassword(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var req models.PasswordChangeRequest
+	var match bool
+	var err error
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading password change request from workspace...")
+	if err = space.Get(passwordChangeRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading password change request (%s)", err.Error())
+		return err
+	}
+
+	if acct.PasswordHash == "" {
+		log.Printf("[HANDLER]: account has no password (logs in through an identity provider)")
+		return ErrIncorrectPassword
+	}
+
+	log.Printf("[HANDLER]: comparing current password provided in request and stored password hash...")
+	if match, err = argon2id.ComparePasswordAndHash(req.CurrentPassword, acct.PasswordHash); err != nil {
+		log.Printf("[HANDLER]: error comparing password and hash (%s)", err.Error())
+		return err
+	}
+	if !match {
+		log.Printf("[HANDLER]: mismatch comparing password and hash")
+		return ErrIncorrectPassword
+	}
+
+	log.Printf("[HANDLER]: password and hash match")
+	return nil
+}
+
+// Function `applyPendingPassword` hashes the pending password within the workspace and stores it as the password of the active user
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func applyPendingPassword(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var password string
+	var userid bson.ObjectID
+	var hash string
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID from workspace under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading pending password from workspace under %q...", pendingPasswordKey)
+	if err = space.Get(pendingPasswordKey, &password); err != nil {
+		log.Printf("[HANDLER]: error loading pending password (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: hashing password...")
+	if hash, err = argon2id.CreateHash(password, argon2id.DefaultParams); err != nil {
+		log.Printf("[HANDLER]: error hashing password (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "password_hash", Value: hash}, {Key: "metadata.updated_at", Value: now}}}},
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: no active account (_id=%q) to update", whoami)
+		return mongo.ErrNoDocuments
+	}
+
+	log.Printf("[HANDLER]: password of account (_id=%q) updated", whoami)
+	return nil
+}
+
+// Function `discardPasswordResetTokens` removes every outstanding password reset token of the active user
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func discardPasswordResetTokens(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var sess *mongo.Session
+	var res *mongo.DeleteResult
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID from workspace under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation (authorizes=%q)", whoami)
+	res, err = sess.Client().
+		Database(models.PasswordResetQueryContext.Database).
+		Collection(models.PasswordResetQueryContext.Collection).
+		DeleteMany(ctx, bson.D{{Key: "authorizes", Value: userid}})
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database deletion (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: removed %d password reset tokens of user %q", res.DeletedCount, whoami)
+	return nil
+}
+
+// Function `fetchResettableAccountByEmail` retrieves the account record matching the email in the password reset request
+//
+// An unknown email is not an error so that the response does not reveal which emails have accounts; later steps do nothing in that case.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchResettableAccountByEmail(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.PasswordResetRequest
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading password reset request from workspace under key %q", passwordResetRequestKey)
+	if err = space.Get(passwordResetRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading password reset request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{{Key: "login_email", Value: req.Email}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, filter).
+		Decode(&acct)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no active account found for password reset request")
+		return nil
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	space.Set(userAccountRecordKey, acct)
+	return nil
+}
+
+// Function `createPasswordResetToken` issues a single-use password reset token for the account within the workspace and stores its hash
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createPasswordResetToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var opts models.PasswordResetOptions
+	var sess *mongo.Session
+	var cfg *options.InsertOneOptionsBuilder
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading account record from workspace (if any)...")
+	if err = space.Get(userAccountRecordKey, &acct); errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: no account to issue a password reset token for, skipping")
+		return nil
+	} else if err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading password reset options from workspace under %q key...", passwordResetOptionsKey)
+	if err = space.Get(passwordResetOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading password reset options (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating password reset token...")
+	raw := rand.Text()
+	record := models.PasswordResetToken{
+		ID:            fmt.Sprintf("%x", sha256.Sum256([]byte(raw))),
+		Authorizes:    acct.ID,
+		NotValidAfter: now.Add(cmp.Or(opts.ExpiresIn, defaultPasswordResetTTL)),
+		CreatedAt:     now,
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation")
+	if _, err = sess.Client().
+		Database(models.PasswordResetQueryContext.Database).
+		Collection(models.PasswordResetQueryContext.Collection).
+		InsertOne(ctx, record, cfg); err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(passwordResetTokenKey, raw)
+	log.Printf("[HANDLER]: password reset token issued (authorizes=%q, expires=%s)", acct.ID.Hex(), record.NotValidAfter)
+	return nil
+}
+
+// Function `deliverPasswordResetToken` sends the password reset token within the workspace to the login email of the account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func deliverPasswordResetToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var raw string
+	var acct models.UserAccount
+	var opts models.PasswordResetOptions
+	var err error
+
+	log.Printf("[HANDLER]: loading password reset token from workspace under %q key (if any)...", passwordResetTokenKey)
+	if err = space.Get(passwordResetTokenKey, &raw); errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: no password reset token to deliver, skipping")
+		return nil
+	} else if err != nil {
+		log.Printf("[HANDLER]: error loading password reset token (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading password reset options from workspace under %q key...", passwordResetOptionsKey)
+	if err = space.Get(passwordResetOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading password reset options (%s)", err.Error())
+		return err
+	}
+
+	msg := notify.Message{
+		Recipient: acct.LoginEmail,
+		Subject:   "Reset your Tournabyte password",
+		Body: fmt.Sprintf(
+			"A password reset was requested for your Tournabyte account.\n\n"+
+				"Use the following token to choose a new password: %s\n\n"+
+				"The token expires in %s and can only be used once. If you did not request a reset, you can ignore this message.\n",
+			raw,
+			cmp.Or(opts.ExpiresIn, defaultPasswordResetTTL),
+		),
+	}
+
+	log.Printf("[HANDLER]: delivering password reset token...")
+	if err = opts.Notifier.Notify(ctx, msg); err != nil {
+		log.Printf("[HANDLER]: error delivering password reset token (%s)", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Function `confirmPasswordResetRequest` populates the response for a password reset request (identical whether or not an account was found)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func confirmPasswordResetRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	log.Printf("[HANDLER]: saved response structure to workspace")
+	space.Set(passwordResetResponseKey, gin.H{"resetRequested": time.Now().UTC()})
+	return nil
+}
+
+// Function `consumePasswordResetToken` removes the unexpired reset token matching the one presented and notes the user it authorizes
+//
+// Removing the token as part of the lookup ensures each token is only ever redeemed once.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidPasswordResetToken` if no unexpired token matches (or another error that occurred during this processing step)
+func consumePasswordResetToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.PasswordResetRedemption
+	var record models.PasswordResetToken
+	var sess *mongo.Session
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading password reset redemption from workspace under key %q", passwordResetRedemptionKey)
+	if err = space.Get(passwordResetRedemptionKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading password reset redemption (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation")
+	filter := bson.D{
+		{Key: "_id", Value: fmt.Sprintf("%x", sha256.Sum256([]byte(req.Token)))},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: now}}},
+	}
+	err = sess.Client().
+		Database(models.PasswordResetQueryContext.Database).
+		Collection(models.PasswordResetQueryContext.Collection).
+		FindOneAndDelete(ctx, filter).
+		Decode(&record)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no unexpired password reset token matches the one presented")
+		return ErrInvalidPasswordResetToken
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database removal (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: password reset token redeemed and token owner noted in workspace under %q", activeUserID)
+	space.Set(activeUserID, record.Authorizes.Hex())
+	return nil
+}
+
+// Function `ensurePasswordResetIndexes` creates the TTL index removing password reset tokens once they expire
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the index (nil if no issue occurred)
+func ensurePasswordResetIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.PasswordResetQueryContext.Database).
+		Collection(models.PasswordResetQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "not_valid_after", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		})
+	return err
+}
+
+// Function `isPasswordCredentialError` determines if the given error is a rejected password or password reset token
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isPasswordCredentialError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrIncorrectPassword):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("currentPassword", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidPasswordResetToken):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("token", e.Error()),
+		), true
+	}
+	return nil, false
+}
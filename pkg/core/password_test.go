@@ -0,0 +1,212 @@
+package core
+
+/*
+ * File: pkg/core/password_test.go
+ *
+ * Purpose: unit tests for the password change and password reset pipelines
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"github.com/tournabyte/webapi/pkg/notify"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	findNoUserOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.users"},
+			{Key: "firstBatch", Value: bson.A{}},
+		}},
+	}
+	consumeResetTokenOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 1}}},
+		{Key: "value", Value: bson.M{
+			"_id":             "abc123",
+			"authorizes":      bson.NewObjectID(),
+			"not_valid_after": time.Now().UTC().Add(time.Minute),
+			"created_at":      time.Now().UTC(),
+		}},
+	}
+	consumeResetTokenMissing = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 0}}},
+		{Key: "value", Value: nil},
+	}
+)
+
+func bindBodyTo(space *handlerutil.HandlerWorkspace, body any) {
+	var bindings handlerutil.Bindings
+	_ = space.Get(handlerutil.RequestBindings, &bindings)
+
+	bindings.Body = func(a any) error {
+		outVal := reflect.ValueOf(a)
+		if outVal.Kind() != reflect.Pointer || outVal.IsNil() {
+			return handlerutil.ErrNotAddressable
+		}
+
+		valVal := reflect.ValueOf(body)
+		if !valVal.Type().AssignableTo(outVal.Type().Elem()) {
+			return handlerutil.ErrNotAssignable
+		}
+		outVal.Elem().Set(valVal)
+		return nil
+	}
+	space.Set(handlerutil.RequestBindings, bindings)
+}
+
+func setupPasswordResetWorkspace(t *testing.T, body any, sent *[]notify.Message) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := handlerutil.DefaultWorkspace()
+
+	bindBodyTo(&space, body)
+	space.Set(authTokenOptionsKey, models.TokenOptions{})
+	space.Set(passwordResetOptionsKey, models.PasswordResetOptions{
+		ExpiresIn: time.Minute,
+		Notifier: notify.NotifierFunc(func(ctx context.Context, msg notify.Message) error {
+			*sent = append(*sent, msg)
+			return nil
+		}),
+	})
+	return &space
+}
+
+func TestPasswordChangePipeline(t *testing.T) {
+	t.Run("PasswordChangedAndSessionsClosed", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := passwordChangePipeline(setupWorkingAdminContext(t, findUserOk, updateOneOk, deleteManyOk, deleteManyOk))
+		var result models.SessionRevocationResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+		bindBodyTo(space, models.PasswordChangeRequest{CurrentPassword: "s3cr3tk3y", NewPassword: "n3ws3cr3tk3y"})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(sessionRevocationResponseKey, &result))
+
+		assert.Equal(t, int64(3), result.Revoked)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("IncorrectCurrentPasswordRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := passwordChangePipeline(setupWorkingAdminContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+		bindBodyTo(space, models.PasswordChangeRequest{CurrentPassword: "wrongpassword", NewPassword: "n3ws3cr3tk3y"})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrIncorrectPassword)
+	})
+}
+
+func TestPasswordResetPipeline(t *testing.T) {
+	t.Run("ResetTokenDelivered", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := passwordResetRequestPipeline(setupWorkingAdminContext(t, findUserOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupPasswordResetWorkspace(t, models.PasswordResetRequest{Email: "testuser@example.io"}, &sent)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+
+		var raw string
+		require.NoError(t, after.Get(passwordResetTokenKey, &raw))
+		require.Len(t, sent, 1)
+		assert.Equal(t, "testuser@example.io", sent[0].Recipient)
+		assert.Contains(t, sent[0].Body, raw)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UnknownEmailNotRevealed", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := passwordResetRequestPipeline(setupWorkingAdminContext(t, findNoUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupPasswordResetWorkspace(t, models.PasswordResetRequest{Email: "nobody@example.io"}, &sent)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		assert.NoError(t, after.Get(passwordResetResponseKey, new(map[string]any)))
+		assert.Empty(t, sent)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("ResetTokenRedeemed", func(t *testing.T) {
+		var sent []notify.Message
+		var result models.SessionRevocationResponse
+		pCtx, pCancel, pIn, pOut := passwordResetRedemptionPipeline(setupWorkingAdminContext(t, consumeResetTokenOk, updateOneOk, deleteManyOk, deleteManyOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupPasswordResetWorkspace(t, models.PasswordResetRedemption{Token: "abc123", NewPassword: "n3ws3cr3tk3y"}, &sent)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(sessionRevocationResponseKey, &result))
+
+		assert.Equal(t, int64(3), result.Revoked)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UnknownResetTokenRejected", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := passwordResetRedemptionPipeline(setupWorkingAdminContext(t, consumeResetTokenMissing))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupPasswordResetWorkspace(t, models.PasswordResetRedemption{Token: "abc123", NewPassword: "n3ws3cr3tk3y"}, &sent)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidPasswordResetToken)
+	})
+}
@@ -0,0 +1,1247 @@
+package core
+
+/*
+ * File: pkg/core/privacy.go
+ *
+ * Purpose: personal data exports and self-service account deletion
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Defaults applied to personal data exports when not configured
+const (
+	defaultDataExportBucket  = "tournabyte-exports"
+	defaultDataExportTTL     = 7 * 24 * time.Hour
+	defaultDataExportLinkTTL = 15 * time.Minute
+)
+
+// Duration the generation of a single personal data archive may take
+const dataExportGenerationTimeout = 5 * time.Minute
+
+// Timings of waiting for the transaction storing a data export record to commit before its archive is generated
+const (
+	dataExportCommitTimeout      = time.Minute
+	dataExportCommitPollInterval = 200 * time.Millisecond
+)
+
+// Display name given to participants once the user they referenced deletes their account
+const deletedUserDisplayName = "Deleted user"
+
+// Workspace keys associated with data export and account deletion workspace tasks
+const (
+	dataExportOptionsKey       = "dataExportOptions"
+	dataExportLookupRequestKey = "dataExportLookupRequest"
+	dataExportRecordKey        = "dataExportRecord"
+	dataExportResponseKey      = "dataExportResponse"
+	accountDeletionRequestKey  = "accountDeletionRequest"
+	accountDeletionResponseKey = "accountDeletionResponse"
+)
+
+// Errors specific to data export and account deletion workflow tasks
+var (
+	ErrDataExportInProgress     = errors.New("a data export is already being generated")
+	ErrExportStorageUnavailable = errors.New("data export storage is unavailable")
+	ErrInvalidHandover          = errors.New("hosted events can only be handed over to another active user")
+)
+
+// Type `minioDataExporter` generates personal data archives in the background and stores them within a MinIO bucket
+//
+// Members:
+//   - s3: the connection to the MinIO deployment holding the archives
+//   - bucket: the bucket the archives are stored in
+//   - linkTTL: the duration a pre-signed download link remains valid
+type minioDataExporter struct {
+	s3      *dbx.MinioConnection
+	bucket  string
+	linkTTL time.Duration
+}
+
+// Function `newMinioDataExporter` creates a data exporter storing archives through the given connection (zero values fall back to the defaults)
+//
+// Parameters:
+//   - s3: the connection to the MinIO deployment holding the archives
+//   - bucket: the bucket the archives are stored in
+//   - linkTTL: the duration a pre-signed download link remains valid
+//
+// Returns:
+//   - `*minioDataExporter`: the data exporter
+func newMinioDataExporter(s3 *dbx.MinioConnection, bucket string, linkTTL time.Duration) *minioDataExporter {
+	return &minioDataExporter{
+		s3:      s3,
+		bucket:  cmp.Or(bucket, defaultDataExportBucket),
+		linkTTL: cmp.Or(linkTTL, defaultDataExportLinkTTL),
+	}
+}
+
+// Function `(*minioDataExporter).Schedule` starts generating the archive of the given export in the background
+//
+// The archive is generated through a database session independent of the request, so it outlives both the request and its transaction.
+// Generation only starts once the export record is visible to that session, i.e. after the request transaction committed; an export whose
+// transaction never commits is abandoned without generating an archive. Failures are logged since nobody is left to report them to.
+//
+// Parameters:
+//   - ctx: the request context holding the database session
+//   - export: the export to generate the archive of
+//
+// Returns:
+//   - `error`: issue starting the independent database session (nil if no issue occurred)
+func (exporter *minioDataExporter) Schedule(ctx context.Context, export models.DataExport) error {
+	detachedCtx, end, err := detachedSession(context.WithoutCancel(ctx))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer end()
+
+		log.Printf("[EXPORT]: waiting for data export (_id=%q) to be committed", export.ID.Hex())
+		if err := awaitDataExportRecord(detachedCtx, export); err != nil {
+			log.Printf("[EXPORT]: abandoning data export (_id=%q) that was never committed: %s", export.ID.Hex(), err.Error())
+			return
+		}
+
+		genCtx, cancel := context.WithTimeout(exporter.s3.SetUpSession(detachedCtx), dataExportGenerationTimeout)
+		defer cancel()
+
+		log.Printf("[EXPORT]: generating data export (_id=%q) of user %q", export.ID.Hex(), export.Owner.Hex())
+		status := models.DataExportReady
+		if err := exporter.store(genCtx, export); err != nil {
+			log.Printf("[EXPORT]: error generating data export (_id=%q): %s", export.ID.Hex(), err.Error())
+			status = models.DataExportFailed
+		}
+
+		if err := exporter.complete(genCtx, export, status); err != nil {
+			log.Printf("[EXPORT]: error completing data export (_id=%q): %s", export.ID.Hex(), err.Error())
+		}
+	}()
+	return nil
+}
+
+// Function `awaitDataExportRecord` waits until the given export record is visible outside the transaction that stored it
+//
+// Parameters:
+//   - ctx: the context holding a database session independent of the request
+//   - export: the export to wait for
+//
+// Returns:
+//   - `error`: the context error if the record did not appear in time, or an issue reading the database (nil once the record is visible)
+func awaitDataExportRecord(ctx context.Context, export models.DataExport) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, dataExportCommitTimeout)
+	defer cancel()
+
+	coll := sess.Client().
+		Database(models.DataExportQueryContext.Database).
+		Collection(models.DataExportQueryContext.Collection)
+	for {
+		err = coll.FindOne(waitCtx, bson.D{{Key: "_id", Value: export.ID}}).Err()
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return waitCtx.Err()
+		case <-time.After(dataExportCommitPollInterval):
+		}
+	}
+}
+
+// Function `(*minioDataExporter).DownloadURL` creates a pre-signed link downloading the archive of the given export
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of the request
+//   - export: the export to download the archive of
+//
+// Returns:
+//   - `string`: the pre-signed download link
+//   - `time.Time`: the timestamp the download link stops working
+//   - `error`: `ErrExportStorageUnavailable` if the link could not be signed
+func (exporter *minioDataExporter) DownloadURL(ctx context.Context, export models.DataExport) (string, time.Time, error) {
+	client, err := dbx.MinioFromContext(exporter.s3.SetUpSession(ctx))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	ttl := min(exporter.linkTTL, time.Until(export.NotValidAfter))
+	params := url.Values{}
+	params.Set("response-content-disposition", fmt.Sprintf("attachment; filename=%q", "tournabyte-export-"+export.ID.Hex()+".json"))
+
+	link, err := client.PresignedGetObject(ctx, exporter.bucket, export.Object, ttl, params)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %v", ErrExportStorageUnavailable, err)
+	}
+	return link.String(), time.Now().UTC().Add(ttl), nil
+}
+
+// Function `(*minioDataExporter).Discard` removes every stored archive of the given user
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of the request
+//   - owner: the user whose archives are removed
+//
+// Returns:
+//   - `error`: `ErrExportStorageUnavailable` if an archive could not be listed or removed
+func (exporter *minioDataExporter) Discard(ctx context.Context, owner bson.ObjectID) error {
+	client, err := dbx.MinioFromContext(exporter.s3.SetUpSession(ctx))
+	if err != nil {
+		return err
+	}
+
+	for object := range client.ListObjects(ctx, exporter.bucket, minio.ListObjectsOptions{Prefix: owner.Hex() + "/", Recursive: true}) {
+		if object.Err != nil {
+			if minio.ToErrorResponse(object.Err).Code == minio.NoSuchBucket {
+				return nil
+			}
+			return fmt.Errorf("%w: %v", ErrExportStorageUnavailable, object.Err)
+		}
+		if err := client.RemoveObject(ctx, exporter.bucket, object.Key, minio.RemoveObjectOptions{}); err != nil {
+			return fmt.Errorf("%w: %v", ErrExportStorageUnavailable, err)
+		}
+	}
+	return nil
+}
+
+// Function `(*minioDataExporter).store` generates the archive of the given export and uploads it to the export bucket
+//
+// Parameters:
+//   - ctx: the context holding the database session and the MinIO client
+//   - export: the export to generate the archive of
+//
+// Returns:
+//   - `error`: issue collecting, encoding or uploading the archive (nil if no issue occurred)
+func (exporter *minioDataExporter) store(ctx context.Context, export models.DataExport) error {
+	client, err := dbx.MinioFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	archive, err := collectPersonalData(ctx, export.Owner)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	exists, err := client.BucketExists(ctx, exporter.bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err = client.MakeBucket(ctx, exporter.bucket, minio.MakeBucketOptions{}); err != nil {
+			return err
+		}
+	}
+
+	opts, err := dbx.NewOptions(
+		dbx.PutObjectContentType("application/json"),
+		dbx.PutObjectMetadata("owner", export.Owner.Hex()),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.PutObject(ctx, exporter.bucket, export.Object, bytes.NewReader(encoded), int64(len(encoded)), *opts)
+	return err
+}
+
+// Function `(*minioDataExporter).complete` records the outcome of generating the archive of the given export
+//
+// The stored archive is removed when the export record no longer exists (i.e. the account was deleted in the meantime).
+//
+// Parameters:
+//   - ctx: the context holding the database session and the MinIO client
+//   - export: the export whose archive was generated
+//   - status: the outcome of generating the archive
+//
+// Returns:
+//   - `error`: issue updating the export record or removing the orphaned archive (nil if no issue occurred)
+func (exporter *minioDataExporter) complete(ctx context.Context, export models.DataExport, status string) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := sess.Client().
+		Database(models.DataExportQueryContext.Database).
+		Collection(models.DataExportQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: export.ID}, {Key: "status", Value: models.DataExportPending}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "status", Value: status}, {Key: "completed_at", Value: time.Now().UTC()}}}},
+		)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 && status == models.DataExportReady {
+		log.Printf("[EXPORT]: data export (_id=%q) was withdrawn, removing its archive", export.ID.Hex())
+		client, err := dbx.MinioFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		return client.RemoveObject(ctx, exporter.bucket, export.Object, minio.RemoveObjectOptions{})
+	}
+	if res.MatchedCount == 0 {
+		log.Printf("[EXPORT]: data export (_id=%q) was withdrawn before its failure could be recorded", export.ID.Hex())
+	}
+	return nil
+}
+
+// Function `(*tournabyteAPIService).initPrivacyWorkspace` initializes the handler workspace for a data export or account deletion request
+// handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for exporting personal data or deleting the account of the current user
+func (srv *tournabyteAPIService) initPrivacyWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(dataExportOptionsKey, srv.getDataExportConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings and data export configurations")
+	return &space
+}
+
+// Function `dataExportRequestPipeline` initializes a handling pipeline for requesting an archive of the personal data of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func dataExportRequestPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, createDataExportRecord, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, scheduleDataExport, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateDataExportResponse, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `dataExportLookupPipeline` initializes a handling pipeline for checking on a personal data export of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func dataExportLookupPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindDataExportLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchDataExportRecord, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateDataExportResponse, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `accountDeletionPipeline` initializes a handling pipeline for deleting the account of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func accountDeletionPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccountDeletionRequest, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyDeletionPassword, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, handOverHostedEvents, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, anonymiseParticipantReferences, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeSessionsOfUser, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, removePersonalRecords, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeAccountRecord, out9)
+	out11 := handlerutil.Stage(pipelineCtx, pipelineCancel, discardDataExports, out10)
+	out12 := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(activeUserTokenScope), out11)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateAccountDeletionResponse, out12)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `createDataExportRecord` stores a pending data export of the user presented in the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrDataExportInProgress` if an export of the user is still pending (or another error that occurred during this processing step)
+func createDataExportRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var opts models.DataExportOptions
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading data export options from workspace under %q key...", dataExportOptionsKey)
+	if err = space.Get(dataExportOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading data export options (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	coll := sess.Client().
+		Database(models.DataExportQueryContext.Database).
+		Collection(models.DataExportQueryContext.Collection)
+
+	log.Printf("[HANDLER]: performing database lookup operation (pending exports)")
+	err = coll.FindOne(ctx, bson.D{{Key: "owner", Value: userid}, {Key: "status", Value: models.DataExportPending}}).Err()
+	if err == nil {
+		log.Printf("[HANDLER]: data export of user %q still pending", whoami)
+		return ErrDataExportInProgress
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	now := time.Now().UTC()
+	export := models.DataExport{
+		ID:            bson.NewObjectID(),
+		Owner:         userid,
+		Status:        models.DataExportPending,
+		RequestedAt:   now,
+		NotValidAfter: now.Add(cmp.Or(opts.ExpiresIn, defaultDataExportTTL)),
+	}
+	export.Object = userid.Hex() + "/" + export.ID.Hex() + ".json"
+
+	log.Printf("[HANDLER]: performing database insert operation")
+	if _, err = coll.InsertOne(ctx, export); err != nil {
+		log.Printf("[HANDLER]: error performing database insert (%s)", err.Error())
+		return err
+	}
+
+	space.Set(dataExportRecordKey, export)
+	log.Printf("[HANDLER]: stored data export (_id=%q) of user %q", export.ID.Hex(), whoami)
+	return nil
+}
+
+// Function `scheduleDataExport` starts generating the archive of the data export record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func scheduleDataExport(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var export models.DataExport
+	var opts models.DataExportOptions
+
+	log.Printf("[HANDLER]: loading data export record from workspace under %q key...", dataExportRecordKey)
+	if err := space.Get(dataExportRecordKey, &export); err != nil {
+		log.Printf("[HANDLER]: error loading data export record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading data export options from workspace under %q key...", dataExportOptionsKey)
+	if err := space.Get(dataExportOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading data export options (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: scheduling data export (_id=%q)", export.ID.Hex())
+	if err := opts.Exporter.Schedule(ctx, export); err != nil {
+		log.Printf("[HANDLER]: error scheduling data export (%s)", err.Error())
+		return err
+	}
+	return nil
+}
+
+// Function `bindDataExportLookupRequestFromURI` binds the request URI values and saves them to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the data export lookup process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindDataExportLookupRequestFromURI(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.DataExportLookupRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request URI to variable of type %T", lookup)
+	if err := bindings.BindURI(&lookup); err != nil {
+		log.Printf("[HANDLER]: error binding request URI (%s)", err.Error())
+		return err
+	}
+
+	space.Set(dataExportLookupRequestKey, lookup)
+	log.Printf("[HANDLER]: saved request URI as variable of type %T within workspace under key %q", lookup, dataExportLookupRequestKey)
+	return nil
+}
+
+// Function `fetchDataExportRecord` retrieves the requested data export of the user presented in the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchDataExportRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var lookup models.DataExportLookupRequest
+	var userid, exportid bson.ObjectID
+	var export models.DataExport
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading data export lookup request from workspace under %q key...", dataExportLookupRequestKey)
+	if err = space.Get(dataExportLookupRequestKey, &lookup); err != nil {
+		log.Printf("[HANDLER]: error loading data export lookup request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID and export ID hex to ObjectIDs...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+	if exportid, err = bson.ObjectIDFromHex(lookup.ID); err != nil {
+		log.Printf("[HANDLER]: error converting export ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{
+		{Key: "_id", Value: exportid},
+		{Key: "owner", Value: userid},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}
+	err = sess.Client().
+		Database(models.DataExportQueryContext.Database).
+		Collection(models.DataExportQueryContext.Collection).
+		FindOne(ctx, filter).
+		Decode(&export)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	space.Set(dataExportRecordKey, export)
+	return nil
+}
+
+// Function `populateDataExportResponse` saves the data export record within the workspace as the response (signing a download link once the
+// archive is ready)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func populateDataExportResponse(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var export models.DataExport
+	var opts models.DataExportOptions
+
+	log.Printf("[HANDLER]: loading data export record from workspace under %q key...", dataExportRecordKey)
+	if err := space.Get(dataExportRecordKey, &export); err != nil {
+		log.Printf("[HANDLER]: error loading data export record (%s)", err.Error())
+		return err
+	}
+
+	response := models.DataExportStatus{DataExport: export}
+	if export.Status == models.DataExportReady {
+		log.Printf("[HANDLER]: loading data export options from workspace under %q key...", dataExportOptionsKey)
+		if err := space.Get(dataExportOptionsKey, &opts); err != nil {
+			log.Printf("[HANDLER]: error loading data export options (%s)", err.Error())
+			return err
+		}
+
+		log.Printf("[HANDLER]: signing download link of data export (_id=%q)", export.ID.Hex())
+		link, expires, err := opts.Exporter.DownloadURL(ctx, export)
+		if err != nil {
+			log.Printf("[HANDLER]: error signing download link (%s)", err.Error())
+			return err
+		}
+		response.DownloadURL = link
+		response.DownloadExpiresAt = &expires
+	}
+
+	space.Set(dataExportResponseKey, response)
+	log.Printf("[HANDLER]: saved response data to workspace under the %q key", dataExportResponseKey)
+	return nil
+}
+
+// Function `bindAccountDeletionRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the account deletion process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindAccountDeletionRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.AccountDeletionRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(accountDeletionRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, accountDeletionRequestKey)
+	return nil
+}
+
+// Function `verifyDeletionPassword` compares the password presented in the account deletion request with the stored password hash
+//
+// Accounts without a password (logging in through an identity provider only) are confirmed by the access token alone.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrIncorrectPassword` if the password does not match (or another error that occurred during this processing step)
+func verifyDeletionPassword(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var req models.AccountDeletionRequest
+	var match bool
+	var err error
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading account deletion request from workspace...")
+	if err = space.Get(accountDeletionRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading account deletion request (%s)", err.Error())
+		return err
+	}
+
+	if acct.PasswordHash == "" {
+		log.Printf("[HANDLER]: account has no password (logs in through an identity provider)")
+		return nil
+	}
+
+	log.Printf("[HANDLER]: comparing password provided in request and stored password hash...")
+	if match, err = argon2id.ComparePasswordAndHash(req.Password, acct.PasswordHash); err != nil {
+		log.Printf("[HANDLER]: error comparing password and hash (%s)", err.Error())
+		return err
+	}
+	if !match {
+		log.Printf("[HANDLER]: mismatch comparing password and hash")
+		return ErrIncorrectPassword
+	}
+
+	log.Printf("[HANDLER]: password and hash match")
+	return nil
+}
+
+// Function `handOverHostedEvents` hands the events hosted by the account record within the workspace over to the user named in the deletion
+// request (or archives the active ones along with their participants and matches when no user is named) and removes the account from the staff
+// of every other event
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidHandover` if the named user is not another active user (or another error that occurred during this processing step)
+func handOverHostedEvents(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var req models.AccountDeletionRequest
+	var response models.AccountDeletionResponse
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading account deletion request from workspace...")
+	if err = space.Get(accountDeletionRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading account deletion request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	events := sess.Client().Database(models.EventQueryContext.Database).Collection(models.EventQueryContext.Collection)
+	now := time.Now().UTC()
+
+	if req.TransferTo != "" {
+		var transferee bson.ObjectID
+
+		log.Printf("[HANDLER]: converting transferee ID hex to an ObjectID...")
+		if transferee, err = bson.ObjectIDFromHex(req.TransferTo); err != nil {
+			log.Printf("[HANDLER]: error converting transferee ID hex to ObjectID (%s)", err.Error())
+			return err
+		}
+		if transferee == acct.ID {
+			log.Printf("[HANDLER]: hosted events cannot be handed over to the deleted account")
+			return ErrInvalidHandover
+		}
+
+		log.Printf("[HANDLER]: performing database lookup operation (transferee)")
+		filter := bson.D{{Key: "_id", Value: transferee}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+		err = sess.Client().
+			Database(models.UserAccountQueryContext.Database).
+			Collection(models.UserAccountQueryContext.Collection).
+			FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).
+			Err()
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			log.Printf("[HANDLER]: no active account (_id=%q) to hand hosted events over to", req.TransferTo)
+			return ErrInvalidHandover
+		}
+		if err != nil {
+			log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+			return err
+		}
+
+		log.Printf("[HANDLER]: running database update (handing over hosted events)...")
+		res, err = events.UpdateMany(
+			ctx,
+			bson.D{{Key: "host", Value: acct.ID}},
+			bson.D{
+				{Key: "$set", Value: bson.D{{Key: "host", Value: transferee}, {Key: "metadata.updated_at", Value: now}}},
+				{Key: "$pull", Value: bson.D{{Key: "staff", Value: bson.D{{Key: "user", Value: transferee}}}}},
+			},
+		)
+		if err != nil {
+			log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+			return err
+		}
+		response.EventsTransferred = res.ModifiedCount
+		log.Printf("[HANDLER]: handed %d events over to user %q", res.ModifiedCount, req.TransferTo)
+	} else {
+		var hosted []models.EventRecord
+		var cur *mongo.Cursor
+
+		log.Printf("[HANDLER]: performing database lookup operation (hosted events)")
+		cur, err = events.Find(
+			ctx,
+			bson.D{{Key: "host", Value: acct.ID}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}},
+			options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}}),
+		)
+		if err != nil {
+			log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+			return err
+		}
+		if err = cur.All(ctx, &hosted); err != nil {
+			log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+			return err
+		}
+
+		if len(hosted) > 0 {
+			ids := make([]bson.ObjectID, 0, len(hosted))
+			for _, event := range hosted {
+				ids = append(ids, event.ID)
+			}
+			archive := bson.D{{Key: "$set", Value: bson.D{{Key: "metadata.active", Value: false}, {Key: "metadata.updated_at", Value: now}}}}
+
+			dependents := []struct {
+				collection dbx.QueryContext
+				field      string
+			}{
+				{models.ParticipantQueryContext, "participates_in"},
+				{models.MatchQueryContext, "takes_place_during"},
+				{models.EventQueryContext, "_id"},
+			}
+
+			for _, dependent := range dependents {
+				log.Printf("[HANDLER]: running database update (archiving %s)...", dependent.collection.Collection)
+				res, err = sess.Client().
+					Database(dependent.collection.Database).
+					Collection(dependent.collection.Collection).
+					UpdateMany(
+						ctx,
+						bson.D{{Key: dependent.field, Value: bson.D{{Key: "$in", Value: ids}}}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}},
+						archive,
+					)
+				if err != nil {
+					log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+					return err
+				}
+			}
+			response.EventsArchived = res.ModifiedCount
+		}
+		log.Printf("[HANDLER]: archived %d hosted events", response.EventsArchived)
+	}
+
+	log.Printf("[HANDLER]: running database update (leaving event staff)...")
+	if _, err = events.UpdateMany(
+		ctx,
+		bson.D{{Key: "staff.user", Value: acct.ID}},
+		bson.D{
+			{Key: "$pull", Value: bson.D{{Key: "staff", Value: bson.D{{Key: "user", Value: acct.ID}}}}},
+			{Key: "$set", Value: bson.D{{Key: "metadata.updated_at", Value: now}}},
+		},
+	); err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(accountDeletionResponseKey, response)
+	log.Printf("[HANDLER]: saved response data to workspace under the %q key", accountDeletionResponseKey)
+	return nil
+}
+
+// Function `anonymiseParticipantReferences` removes the reference to the account record within the workspace from every participant
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func anonymiseParticipantReferences(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var response models.AccountDeletionResponse
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading response data from workspace under the %q key...", accountDeletionResponseKey)
+	if err = space.Get(accountDeletionResponseKey, &response); err != nil {
+		log.Printf("[HANDLER]: error loading response data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: running database update (anonymising participants)...")
+	res, err = sess.Client().
+		Database(models.ParticipantQueryContext.Database).
+		Collection(models.ParticipantQueryContext.Collection).
+		UpdateMany(
+			ctx,
+			bson.D{{Key: "user", Value: acct.ID}},
+			bson.D{
+				{Key: "$set", Value: bson.D{{Key: "display_name", Value: deletedUserDisplayName}, {Key: "metadata.updated_at", Value: time.Now().UTC()}}},
+				{Key: "$unset", Value: bson.D{{Key: "user", Value: ""}}},
+			},
+		)
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	response.ParticipantsAnonymised = res.ModifiedCount
+	space.Set(accountDeletionResponseKey, response)
+	log.Printf("[HANDLER]: anonymised %d participants referencing account (_id=%q)", res.ModifiedCount, acct.ID.Hex())
+	return nil
+}
+
+// Function `removePersonalRecords` deletes the API keys, pending tokens, login challenges, data exports and failed login attempts belonging to
+// the account record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func removePersonalRecords(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	records := []struct {
+		collection dbx.QueryContext
+		filter     bson.D
+	}{
+		{models.APIKeyQueryContext, bson.D{{Key: "owner", Value: acct.ID}}},
+		{models.PasswordResetQueryContext, bson.D{{Key: "authorizes", Value: acct.ID}}},
+		{models.EmailVerificationQueryContext, bson.D{{Key: "authorizes", Value: acct.ID}}},
+		{models.MFAChallengeQueryContext, bson.D{{Key: "authorizes", Value: acct.ID}}},
+		{models.DataExportQueryContext, bson.D{{Key: "owner", Value: acct.ID}}},
+		{models.LoginAttemptQueryContext, bson.D{{Key: "_id", Value: "account:" + acct.LoginEmail}}},
+	}
+
+	for _, record := range records {
+		log.Printf("[HANDLER]: running database delete (removing %s)...", record.collection.Collection)
+		res, err := sess.Client().
+			Database(record.collection.Database).
+			Collection(record.collection.Collection).
+			DeleteMany(ctx, record.filter)
+		if err != nil {
+			log.Printf("[HANDLER]: error during database delete operation (%s)", err.Error())
+			return err
+		}
+		log.Printf("[HANDLER]: removed %d %s of account (_id=%q)", res.DeletedCount, record.collection.Collection, acct.ID.Hex())
+	}
+	return nil
+}
+
+// Function `removeAccountRecord` deletes the account record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func removeAccountRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var res *mongo.DeleteResult
+	var err error
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: running database delete...")
+	res, err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		DeleteOne(ctx, bson.D{{Key: "_id", Value: acct.ID}})
+	if err != nil {
+		log.Printf("[HANDLER]: error during database delete operation (%s)", err.Error())
+		return err
+	}
+
+	if res.DeletedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents removed (%d)", res.DeletedCount)
+		return errors.New("delete not properly applied")
+	}
+
+	log.Printf("[HANDLER]: removed account (_id=%q)", acct.ID.Hex())
+	return nil
+}
+
+// Function `discardDataExports` removes the stored personal data archives of the account record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func discardDataExports(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var opts models.DataExportOptions
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading data export options from workspace under %q key...", dataExportOptionsKey)
+	if err := space.Get(dataExportOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading data export options (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: discarding data exports of account (_id=%q)", acct.ID.Hex())
+	if err := opts.Exporter.Discard(ctx, acct.ID); err != nil {
+		log.Printf("[HANDLER]: error discarding data exports (%s)", err.Error())
+		return err
+	}
+	return nil
+}
+
+// Function `populateAccountDeletionResponse` summarises the removal of the account record within the workspace as the response
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func populateAccountDeletionResponse(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var revoked models.SessionRevocationResponse
+	var response models.AccountDeletionResponse
+
+	log.Printf("[HANDLER]: loading user account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading response data from workspace under the %q and %q keys...", accountDeletionResponseKey, sessionRevocationResponseKey)
+	if err := space.Get(accountDeletionResponseKey, &response); err != nil {
+		log.Printf("[HANDLER]: error loading response data (%s)", err.Error())
+		return err
+	}
+	if err := space.Get(sessionRevocationResponseKey, &revoked); err != nil {
+		log.Printf("[HANDLER]: error loading response data (%s)", err.Error())
+		return err
+	}
+
+	response.ID = acct.ID.Hex()
+	response.SessionsClosed = revoked.Revoked
+	space.Set(accountDeletionResponseKey, response)
+	log.Printf("[HANDLER]: saved response data to workspace under the %q key", accountDeletionResponseKey)
+	return nil
+}
+
+// Function `collectPersonalData` gathers everything stored about the given user into a personal data archive
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - userid: the user to gather the data of
+//
+// Returns:
+//   - `models.PersonalDataArchive`: the gathered data
+//   - `error`: issue reading the database (nil if no issue occurred)
+func collectPersonalData(ctx context.Context, userid bson.ObjectID) (models.PersonalDataArchive, error) {
+	var archive models.PersonalDataArchive
+	var acct models.UserAccount
+	var sessions []models.UserSession
+
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return archive, err
+	}
+	db := sess.Client().Database(models.UserAccountQueryContext.Database)
+
+	if err = db.Collection(models.UserAccountQueryContext.Collection).FindOne(ctx, bson.D{{Key: "_id", Value: userid}}).Decode(&acct); err != nil {
+		return archive, err
+	}
+
+	find := func(collection dbx.QueryContext, filter bson.D, into any) error {
+		cur, err := sess.Client().Database(collection.Database).Collection(collection.Collection).Find(ctx, filter)
+		if err != nil {
+			return err
+		}
+		return cur.All(ctx, into)
+	}
+
+	sessionFilter := bson.D{
+		{Key: "authorizes", Value: userid},
+		{Key: "rotated", Value: false},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}
+	if err = find(models.UserSessionQueryContext, sessionFilter, &sessions); err != nil {
+		return archive, err
+	}
+	if err = find(models.APIKeyQueryContext, bson.D{{Key: "owner", Value: userid}}, &archive.APIKeys); err != nil {
+		return archive, err
+	}
+	if err = find(models.EventQueryContext, bson.D{{Key: "host", Value: userid}}, &archive.HostedEvents); err != nil {
+		return archive, err
+	}
+	if err = find(models.ParticipantQueryContext, bson.D{{Key: "user", Value: userid}}, &archive.Participations); err != nil {
+		return archive, err
+	}
+
+	if len(archive.Participations) > 0 {
+		players := make([]bson.ObjectID, 0, len(archive.Participations))
+		for _, participant := range archive.Participations {
+			players = append(players, participant.ID)
+		}
+		matchFilter := bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "home", Value: bson.D{{Key: "$in", Value: players}}}},
+			bson.D{{Key: "away", Value: bson.D{{Key: "$in", Value: players}}}},
+		}}}
+		if err = find(models.MatchQueryContext, matchFilter, &archive.Matches); err != nil {
+			return archive, err
+		}
+	}
+
+	archive.GeneratedAt = time.Now().UTC()
+	archive.Account = models.OwnProfile{
+		PublicProfile: publicProfileOf(acct),
+		Email:         acct.LoginEmail,
+		EmailVerified: acct.EmailVerified,
+		MFAEnabled:    acct.MFA != nil && acct.MFA.Enabled,
+	}
+	archive.Identities = acct.Identities
+	for _, identity := range acct.Identities {
+		archive.Account.LinkedProviders = append(archive.Account.LinkedProviders, identity.Provider)
+	}
+	archive.Sessions = make([]models.ActiveSession, 0, len(sessions))
+	for _, record := range sessions {
+		archive.Sessions = append(archive.Sessions, activeSessionOf(record))
+	}
+	return archive, nil
+}
+
+// Function `ensureDataExportIndexes` creates the TTL index removing expired data exports (along with the index looking up exports by owner)
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the indexes (nil if no issue occurred)
+func ensureDataExportIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.DataExportQueryContext.Database).
+		Collection(models.DataExportQueryContext.Collection).
+		Indexes().
+		CreateMany(ctx, []mongo.IndexModel{
+			{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "status", Value: 1}}},
+			{Keys: bson.D{{Key: "not_valid_after", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		})
+	return err
+}
+
+// Function `isPrivacyError` determines if the given error is a refused data export or account deletion
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isPrivacyError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrDataExportInProgress):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("export", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidHandover):
+		return handlerutil.ErrUnprocessibleEntity(
+			handlerutil.NewDetail("transferTo", e.Error()),
+		), true
+	case errors.Is(e, ErrExportStorageUnavailable):
+		return handlerutil.ErrUpstreamUnreachable(
+			handlerutil.NewDetail("export", ErrExportStorageUnavailable.Error()),
+		), true
+	}
+	return nil, false
+}
@@ -0,0 +1,281 @@
+package core
+
+/*
+ * File: pkg/core/privacy_test.go
+ *
+ * Purpose: unit tests for personal data exports and account deletion
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var exportDoc = bson.M{
+	"_id":             bson.NewObjectID(),
+	"owner":           bson.NewObjectID(),
+	"status":          models.DataExportReady,
+	"object":          "owner/export.json",
+	"requested_at":    time.Now().UTC().Add(-time.Hour),
+	"completed_at":    time.Now().UTC().Add(-time.Minute),
+	"not_valid_after": time.Now().UTC().Add(time.Hour),
+}
+
+var (
+	findExportOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.data_exports"},
+			{Key: "firstBatch", Value: bson.A{exportDoc}},
+		}},
+	}
+	findNoneOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.data_exports"},
+			{Key: "firstBatch", Value: bson.A{}},
+		}},
+	}
+	listUserParticipantsOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.participants"},
+			{Key: "firstBatch", Value: bson.A{
+				bson.M{"_id": bson.NewObjectID(), "display_name": "Test User", "participates_in": bson.NewObjectID()},
+			}},
+		}},
+	}
+)
+
+// Type `stubDataExporter` records the exports scheduled and discarded by the pipelines under test
+type stubDataExporter struct {
+	scheduled []models.DataExport
+	discarded []bson.ObjectID
+}
+
+func (stub *stubDataExporter) Schedule(ctx context.Context, export models.DataExport) error {
+	stub.scheduled = append(stub.scheduled, export)
+	return nil
+}
+
+func (stub *stubDataExporter) DownloadURL(ctx context.Context, export models.DataExport) (string, time.Time, error) {
+	return "https://storage.example.io/" + export.Object, time.Now().UTC().Add(time.Minute), nil
+}
+
+func (stub *stubDataExporter) Discard(ctx context.Context, owner bson.ObjectID) error {
+	stub.discarded = append(stub.discarded, owner)
+	return nil
+}
+
+func setupWorkingPrivacyWorkspace(t *testing.T, uri any, exporter *stubDataExporter) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, uri, nil)
+	space.Set(dataExportOptionsKey, models.DataExportOptions{ExpiresIn: time.Hour, Exporter: exporter})
+	return space
+}
+
+func TestDataExportRequestPipeline(t *testing.T) {
+	t.Run("ExportScheduled", func(t *testing.T) {
+		var result models.DataExportStatus
+		var exporter stubDataExporter
+		pCtx, pCancel, pIn, pOut := dataExportRequestPipeline(setupWorkingAdminContext(t, findNoneOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingPrivacyWorkspace(t, nil, &exporter)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(dataExportResponseKey, &result))
+
+		assert.Equal(t, models.DataExportPending, result.Status)
+		assert.Empty(t, result.DownloadURL)
+		require.Len(t, exporter.scheduled, 1)
+		assert.Equal(t, result.ID, exporter.scheduled[0].ID)
+		assert.Equal(t, exporter.scheduled[0].Owner.Hex()+"/"+result.ID.Hex()+".json", exporter.scheduled[0].Object)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), result.NotValidAfter, time.Minute)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("PendingExportRefused", func(t *testing.T) {
+		var exporter stubDataExporter
+		pCtx, pCancel, pIn, pOut := dataExportRequestPipeline(setupWorkingAdminContext(t, findExportOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingPrivacyWorkspace(t, nil, &exporter)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrDataExportInProgress)
+		assert.Empty(t, exporter.scheduled)
+	})
+}
+
+func TestDataExportLookupPipeline(t *testing.T) {
+	var result models.DataExportStatus
+	var exporter stubDataExporter
+	pCtx, pCancel, pIn, pOut := dataExportLookupPipeline(setupWorkingAdminContext(t, findExportOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	id := exportDoc["_id"].(bson.ObjectID)
+	pIn <- setupWorkingPrivacyWorkspace(t, models.DataExportLookupRequest{ID: id.Hex()}, &exporter)
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	require.NoError(t, after.Get(dataExportResponseKey, &result))
+
+	assert.Equal(t, id, result.ID)
+	assert.Equal(t, models.DataExportReady, result.Status)
+	assert.Equal(t, "https://storage.example.io/owner/export.json", result.DownloadURL)
+	assert.NotNil(t, result.DownloadExpiresAt)
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestCollectPersonalData(t *testing.T) {
+	ctx := setupWorkingAdminContext(t, findProfiledUserOk, findSessionOk, findNoneOk, listEventsOk, listUserParticipantsOk, listMatchesOk)
+
+	archive, err := collectPersonalData(ctx, profiledUserDoc["_id"].(bson.ObjectID))
+	require.NoError(t, err)
+
+	assert.Equal(t, "testuser@example.io", archive.Account.Email)
+	assert.Equal(t, "Test User", archive.Account.DisplayName)
+	assert.Len(t, archive.Sessions, 1)
+	assert.Empty(t, archive.APIKeys)
+	assert.NotEmpty(t, archive.HostedEvents)
+	assert.Len(t, archive.Participations, 1)
+	assert.NotEmpty(t, archive.Matches)
+}
+
+func TestAwaitDataExportRecord(t *testing.T) {
+	ctx := setupWorkingAdminContext(t, findNoneOk, findNoneOk, findExportOk)
+
+	err := awaitDataExportRecord(ctx, models.DataExport{ID: exportDoc["_id"].(bson.ObjectID)})
+	assert.NoError(t, err, "Generation should start once the export record becomes visible")
+}
+
+func TestAccountDeletionPipeline(t *testing.T) {
+	t.Run("HostedEventsArchived", func(t *testing.T) {
+		var result models.AccountDeletionResponse
+		var exporter stubDataExporter
+		pCtx, pCancel, pIn, pOut := accountDeletionPipeline(setupWorkingAdminContext(t,
+			findUserOk, findNoneOk, updateOneOk, updateManyOk, deleteManyOk,
+			deleteOk, deleteOk, deleteOk, deleteOk, deleteOk, deleteOk,
+			deleteOneOk,
+		))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingPrivacyWorkspace(t, nil, &exporter)
+		bindBodyTo(space, models.AccountDeletionRequest{Password: "s3cr3tk3y"})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(accountDeletionResponseKey, &result))
+
+		deleted := findUserDoc[0].(bson.M)["_id"].(bson.ObjectID)
+		assert.Equal(t, deleted.Hex(), result.ID)
+		assert.Equal(t, int64(3), result.SessionsClosed)
+		assert.Equal(t, int64(3), result.ParticipantsAnonymised)
+		assert.Zero(t, result.EventsArchived)
+		assert.Zero(t, result.EventsTransferred)
+		assert.Equal(t, []bson.ObjectID{deleted}, exporter.discarded)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("HostedEventsTransferred", func(t *testing.T) {
+		var result models.AccountDeletionResponse
+		var exporter stubDataExporter
+		pCtx, pCancel, pIn, pOut := accountDeletionPipeline(setupWorkingAdminContext(t,
+			findUserOk, findProfiledUserOk, updateManyOk, updateOneOk, updateManyOk, deleteManyOk,
+			deleteOk, deleteOk, deleteOk, deleteOk, deleteOk, deleteOk,
+			deleteOneOk,
+		))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingPrivacyWorkspace(t, nil, &exporter)
+		bindBodyTo(space, models.AccountDeletionRequest{Password: "s3cr3tk3y", TransferTo: profiledUserDoc["_id"].(bson.ObjectID).Hex()})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(accountDeletionResponseKey, &result))
+		assert.Equal(t, int64(3), result.EventsTransferred)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("HandoverToSelfRefused", func(t *testing.T) {
+		var exporter stubDataExporter
+		pCtx, pCancel, pIn, pOut := accountDeletionPipeline(setupWorkingAdminContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingPrivacyWorkspace(t, nil, &exporter)
+		bindBodyTo(space, models.AccountDeletionRequest{Password: "s3cr3tk3y", TransferTo: findUserDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex()})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidHandover)
+	})
+
+	t.Run("WrongPasswordRefused", func(t *testing.T) {
+		var exporter stubDataExporter
+		pCtx, pCancel, pIn, pOut := accountDeletionPipeline(setupWorkingAdminContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingPrivacyWorkspace(t, nil, &exporter)
+		bindBodyTo(space, models.AccountDeletionRequest{Password: "wrongpassword"})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrIncorrectPassword)
+		assert.Empty(t, exporter.discarded)
+	})
+}
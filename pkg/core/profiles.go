@@ -0,0 +1,456 @@
+package core
+
+/*
+ * File: pkg/core/profiles.go
+ *
+ * Purpose: user profiles and public player profile lookups
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Workspace keys associated with profile workspace tasks
+const (
+	profileUpdateRequestKey = "profileUpdateRequest"
+	profileResponseKey      = "profileResponse"
+)
+
+// Errors specific to profile workflow tasks
+var (
+	ErrUnknownUser = errors.New("no active user account has that ID")
+)
+
+// Function `(*tournabyteAPIService).initProfileWorkspace` initializes the handler workspace for a profile request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for viewing or changing profiles
+func (srv *tournabyteAPIService) initProfileWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
+// Function `ownProfilePipeline` initializes a handling pipeline for viewing the profile of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func ownProfilePipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out2)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateOwnProfileResponse, out3)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `profileUpdatePipeline` initializes a handling pipeline for changing the profile of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func profileUpdatePipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindProfileUpdateRequest, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, updateProfileOfActiveUser, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateOwnProfileResponse, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `publicProfilePipeline` initializes a handling pipeline for viewing the public profile of a user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func publicProfilePipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindUserLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchProfiledAccountRecord, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populatePublicProfileResponse, out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindProfileUpdateRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the profile update process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindProfileUpdateRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.ProfileUpdateRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(profileUpdateRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, profileUpdateRequestKey)
+	return nil
+}
+
+// Function `updateProfileOfActiveUser` applies the profile update request to the account presented in the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func updateProfileOfActiveUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var req models.ProfileUpdateRequest
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading profile update request from workspace under key %q", profileUpdateRequestKey)
+	if err = space.Get(profileUpdateRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading profile update request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	update := profileUpdateOf(req)
+	log.Printf("[HANDLER]: configured update: %v", update)
+
+	log.Printf("[HANDLER]: performing database update operation")
+	filter := bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(&acct)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database update (%s)", err.Error())
+		return err
+	}
+
+	space.Set(userAccountRecordKey, acct)
+	log.Printf("[HANDLER]: profile of account (_id=%q) updated", acct.ID.Hex())
+	return nil
+}
+
+// Function `fetchProfiledAccountRecord` retrieves the active account named in the user lookup request
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchProfiledAccountRecord(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.UserID
+	var userid bson.ObjectID
+	var acct models.UserAccount
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user lookup request from workspace under %q key...", adminUserLookupRequest)
+	if err = space.Get(adminUserLookupRequest, &lookup); err != nil {
+		log.Printf("[HANDLER]: error loading user lookup request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(lookup.ID); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "profile", Value: 1}, {Key: "metadata", Value: 1}})).
+		Decode(&acct)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	space.Set(userAccountRecordKey, acct)
+	return nil
+}
+
+// Function `populateOwnProfileResponse` saves the profile of the account record within the workspace as seen by its owner
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func populateOwnProfileResponse(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+
+	log.Printf("[HANDLER]: loading account record from workspace under key %q", userAccountRecordKey)
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	profile := models.OwnProfile{
+		PublicProfile: publicProfileOf(acct),
+		Email:         acct.LoginEmail,
+		EmailVerified: acct.EmailVerified,
+		MFAEnabled:    acct.MFA != nil && acct.MFA.Enabled,
+	}
+	for _, identity := range acct.Identities {
+		profile.LinkedProviders = append(profile.LinkedProviders, identity.Provider)
+	}
+
+	space.Set(profileResponseKey, profile)
+	log.Printf("[HANDLER]: saved profile as variable of type %T within workspace under key %q", profile, profileResponseKey)
+	return nil
+}
+
+// Function `populatePublicProfileResponse` saves the public projection of the profile of the account record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func populatePublicProfileResponse(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+
+	log.Printf("[HANDLER]: loading account record from workspace under key %q", userAccountRecordKey)
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	profile := publicProfileOf(acct)
+	space.Set(profileResponseKey, profile)
+	log.Printf("[HANDLER]: saved profile as variable of type %T within workspace under key %q", profile, profileResponseKey)
+	return nil
+}
+
+// Function `resolveParticipantUser` verifies the user referenced by the participant request belongs to an active account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrUnknownUser` if no active account has the referenced ID (or another error that occurred during this processing step)
+func resolveParticipantUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.CreateOrModifyParticipantRequest
+	var userid bson.ObjectID
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading request data from workspace under %q into variable of type %T...", participantCreationRequest, req)
+	if err = space.Get(participantCreationRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading request data (%s)", err.Error())
+		return err
+	}
+
+	if req.User == "" {
+		log.Printf("[HANDLER]: participant does not reference a user")
+		return nil
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(req.User); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).
+		Err()
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no active account (_id=%q) to reference", req.User)
+		return ErrUnknownUser
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: participant references account (_id=%q)", req.User)
+	return nil
+}
+
+// Function `profileUpdateOf` translates a profile update request into the update of the account document
+//
+// Omitted fields are left unchanged while fields given as empty values are removed.
+//
+// Parameters:
+//   - req: the profile update request
+//
+// Returns:
+//   - `bson.D`: the update document
+func profileUpdateOf(req models.ProfileUpdateRequest) bson.D {
+	set := bson.D{{Key: "metadata.updated_at", Value: time.Now().UTC()}}
+	unset := bson.D{}
+
+	apply := func(field string, empty bool, value any) {
+		if empty {
+			unset = append(unset, bson.E{Key: "profile." + field, Value: ""})
+		} else {
+			set = append(set, bson.E{Key: "profile." + field, Value: value})
+		}
+	}
+
+	if req.DisplayName != nil {
+		apply("display_name", *req.DisplayName == "", *req.DisplayName)
+	}
+	if req.AvatarURL != nil {
+		apply("avatar_url", *req.AvatarURL == "", *req.AvatarURL)
+	}
+	if req.Bio != nil {
+		apply("bio", *req.Bio == "", *req.Bio)
+	}
+	if req.GameHandles != nil {
+		apply("game_handles", len(*req.GameHandles) == 0, *req.GameHandles)
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	return update
+}
+
+// Function `publicProfileOf` projects an account onto the details any other user may see
+//
+// Parameters:
+//   - acct: the account to project
+//
+// Returns:
+//   - `models.PublicProfile`: the public projection of the account
+func publicProfileOf(acct models.UserAccount) models.PublicProfile {
+	profile := models.PublicProfile{
+		ID:          acct.ID.Hex(),
+		MemberSince: acct.Metadata.CreatedAt,
+	}
+	if acct.Profile != nil {
+		profile.DisplayName = acct.Profile.DisplayName
+		profile.AvatarURL = acct.Profile.AvatarURL
+		profile.Bio = acct.Profile.Bio
+		profile.GameHandles = acct.Profile.GameHandles
+	}
+	return profile
+}
+
+// Function `isProfileError` determines if the given error is a reference to a user that cannot be profiled
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isProfileError(e error) (error, bool) {
+	if errors.Is(e, ErrUnknownUser) {
+		return handlerutil.ErrUnprocessibleEntity(
+			handlerutil.NewDetail("user", e.Error()),
+		), true
+	}
+	return nil, false
+}
@@ -0,0 +1,239 @@
+package core
+
+/*
+ * File: pkg/core/profiles_test.go
+ *
+ * Purpose: unit tests for user profiles and public player profiles
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var profiledUserDoc = bson.M{
+	"_id":            bson.NewObjectID(),
+	"login_email":    "testuser@example.io",
+	"email_verified": true,
+	"profile": bson.M{
+		"display_name": "Test User",
+		"bio":          "plays a lot of games",
+		"game_handles": bson.A{bson.M{"game": "chess", "handle": "testuser"}},
+	},
+	"metadata": bson.M{
+		"active":     true,
+		"created_at": time.Now().UTC().Add(-time.Hour),
+		"updated_at": time.Now().UTC(),
+	},
+}
+
+var (
+	findProfiledUserOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.users"},
+			{Key: "firstBatch", Value: bson.A{profiledUserDoc}},
+		}},
+	}
+	updateProfiledUserOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 1}, {Key: "updatedExisting", Value: true}}},
+		{Key: "value", Value: profiledUserDoc},
+	}
+)
+
+func TestOwnProfilePipeline(t *testing.T) {
+	var result models.OwnProfile
+	pCtx, pCancel, pIn, pOut := ownProfilePipeline(setupWorkingAdminContext(t, findProfiledUserOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	require.NoError(t, after.Get(profileResponseKey, &result))
+
+	assert.Equal(t, "Test User", result.DisplayName)
+	assert.Equal(t, "testuser@example.io", result.Email)
+	assert.True(t, result.EmailVerified)
+	assert.False(t, result.MFAEnabled)
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestProfileUpdatePipeline(t *testing.T) {
+	var result models.OwnProfile
+	pCtx, pCancel, pIn, pOut := profileUpdatePipeline(setupWorkingAdminContext(t, updateProfiledUserOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	name := "Test User"
+	space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+	bindBodyTo(space, models.ProfileUpdateRequest{DisplayName: &name})
+	pIn <- space
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	require.NoError(t, after.Get(profileResponseKey, &result))
+	assert.Equal(t, name, result.DisplayName)
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestProfileUpdateOf(t *testing.T) {
+	name, bio := "Test User", ""
+	handles := []models.GameHandle{{Game: "chess", Handle: "testuser"}}
+
+	update := profileUpdateOf(models.ProfileUpdateRequest{DisplayName: &name, Bio: &bio, GameHandles: &handles})
+	set, unset := update[0].Value.(bson.D), update[1].Value.(bson.D)
+
+	assert.Equal(t, "$set", update[0].Key)
+	assert.Equal(t, bson.E{Key: "profile.display_name", Value: name}, set[1])
+	assert.Equal(t, bson.E{Key: "profile.game_handles", Value: handles}, set[2])
+	assert.Len(t, set, 3)
+	assert.Equal(t, "$unset", update[1].Key)
+	assert.Equal(t, bson.D{{Key: "profile.bio", Value: ""}}, unset)
+
+	untouched := profileUpdateOf(models.ProfileUpdateRequest{})
+	require.Len(t, untouched, 1)
+	assert.Len(t, untouched[0].Value, 1)
+}
+
+func TestProfileUpdateRequestBinding(t *testing.T) {
+	bind := func(t *testing.T, body string) (models.ProfileUpdateRequest, error) {
+		t.Helper()
+		var req models.ProfileUpdateRequest
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		binds := handlerutil.BindingsFromRequestContext(c, handlerutil.ShouldHaveJSONBody)
+		return req, binds.BindBodyAsJSON(&req)
+	}
+
+	t.Run("EmptyValuesClearFields", func(t *testing.T) {
+		req, err := bind(t, `{"displayName": "", "avatarUrl": "", "bio": ""}`)
+		require.NoError(t, err)
+		require.NotNil(t, req.DisplayName)
+		require.NotNil(t, req.AvatarURL)
+		assert.Empty(t, *req.DisplayName)
+		assert.Empty(t, *req.AvatarURL)
+
+		update := profileUpdateOf(req)
+		require.Len(t, update, 2)
+		assert.Len(t, update[1].Value, 3)
+	})
+
+	t.Run("ValuesAccepted", func(t *testing.T) {
+		req, err := bind(t, `{"displayName": "Test User", "avatarUrl": "https://example.io/avatar.png"}`)
+		require.NoError(t, err)
+		assert.Equal(t, "Test User", *req.DisplayName)
+		assert.Nil(t, req.Bio)
+	})
+
+	t.Run("ShortNameRejected", func(t *testing.T) {
+		_, err := bind(t, `{"displayName": "x"}`)
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidAvatarRejected", func(t *testing.T) {
+		_, err := bind(t, `{"avatarUrl": "not a url"}`)
+		assert.Error(t, err)
+	})
+}
+
+func TestPublicProfilePipeline(t *testing.T) {
+	var result models.PublicProfile
+	pCtx, pCancel, pIn, pOut := publicProfilePipeline(setupWorkingAdminContext(t, findProfiledUserOk))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	target := profiledUserDoc["_id"].(bson.ObjectID).Hex()
+	pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, models.UserID{ID: target}, nil)
+
+	after, ok := <-pOut
+	require.True(t, ok, "Reading value from pipeline exit channel failed")
+	require.NoError(t, after.Get(profileResponseKey, &result))
+
+	assert.Equal(t, target, result.ID)
+	assert.Equal(t, "Test User", result.DisplayName)
+	assert.Equal(t, []models.GameHandle{{Game: "chess", Handle: "testuser"}}, result.GameHandles)
+
+	encoded, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "testuser@example.io")
+
+	select {
+	case <-pCtx.Done():
+		require.NoError(t, context.Cause(pCtx))
+	default:
+	}
+}
+
+func TestParticipantReferencesUser(t *testing.T) {
+	t.Run("ReferencedUserExists", func(t *testing.T) {
+		var participant models.EventParticipant
+		pCtx, pCancel, pIn, pOut := createParticipantPipeline(setupWorkingAdminContext(t, findEventOk, findProfiledUserOk, updateOneOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		user := profiledUserDoc["_id"].(bson.ObjectID)
+		space := setupWorkingCreateParticipantWorkspace(t)
+		bindBodyTo(space, models.CreateOrModifyParticipantRequest{DisplayName: "Test User", User: user.Hex()})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(participantRecordKey, &participant))
+		assert.Equal(t, user, participant.User)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UnknownUserRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := createParticipantPipeline(setupWorkingAdminContext(t, findEventOk, findNoUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingCreateParticipantWorkspace(t)
+		bindBodyTo(space, models.CreateOrModifyParticipantRequest{DisplayName: "Test User", User: bson.NewObjectID().Hex()})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrUnknownUser)
+	})
+}
@@ -0,0 +1,431 @@
+package core
+
+/*
+ * File: pkg/core/registrations.go
+ *
+ * Purpose: self-registration of users as event participants
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Workspace keys associated with registration workspace tasks
+const (
+	registrationRequestKey = "registrationRequest"
+)
+
+// Errors specific to registration workflow tasks
+var (
+	ErrRegistrationClosed       = errors.New("event is not open for registration")
+	ErrBracketGenerated         = errors.New("registrations cannot change once the bracket is generated")
+	ErrAlreadyRegistered        = errors.New("user is already registered for the event")
+	ErrRegistrationArchived     = errors.New("user has an archived participant record in the event and cannot register again")
+	ErrNotRegistered            = errors.New("user is not registered for the event")
+	ErrRegistrationNameRequired = errors.New("a participant name is required when the profile has no display name")
+)
+
+// Function `registrationPipeline` initializes a handling pipeline for the current user registering as a participant of an event
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func registrationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyRegistrationOpen, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyBracketNotGenerated, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindRegistrationRequestFromBody, out6)
+	out8 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out7)
+	out9 := handlerutil.Stage(pipelineCtx, pipelineCancel, deriveParticipantRecordFromRegistration, out8)
+	out10 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyNotRegistered, out9)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, createParticipantRecord, out10)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `withdrawalPipeline` initializes a handling pipeline for the current user withdrawing their registration for an event
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func withdrawalPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, verifyBracketNotGenerated, out4)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, removeRegistrationOfActiveUser, out5)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `verifyRegistrationOpen` checks that the event record within the workspace accepts registrations (open for registration and status is
+// "PLANNED")
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrRegistrationClosed` if the event does not accept registrations (or another error that occurred during this processing step)
+func verifyRegistrationOpen(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err := space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading request data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: checking if the event record is open for registration...")
+	if !event.OpenRegistration || event.Status != models.StatusPlanned {
+		log.Printf("[HANDLER]: event (_id=%q, status=%q) does not accept registrations", event.ID.Hex(), event.Status)
+		return ErrRegistrationClosed
+	}
+
+	log.Printf("[HANDLER]: event accepts registrations")
+	return nil
+}
+
+// Function `verifyBracketNotGenerated` checks that no match set exists for the event record within the workspace (status is "PLANNED" and no
+// active match references the event)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrBracketGenerated` if the event has a match set (or another error that occurred during this processing step)
+func verifyBracketNotGenerated(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading request data (%s)", err.Error())
+		return err
+	}
+
+	if event.Status != models.StatusPlanned {
+		log.Printf("[HANDLER]: event status field is %q; the bracket has been generated", event.Status)
+		return ErrBracketGenerated
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation (match set)")
+	filter := bson.D{{Key: "takes_place_during", Value: event.ID}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.MatchQueryContext.Database).
+		Collection(models.MatchQueryContext.Collection).
+		FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).
+		Err()
+
+	if err == nil {
+		log.Printf("[HANDLER]: event (_id=%q) has a match set", event.ID.Hex())
+		return ErrBracketGenerated
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: event has no match set")
+	return nil
+}
+
+// Function `bindRegistrationRequestFromBody` binds the request body and saves it to the handler workspace for later processing
+//
+// The body is optional; a request without one registers under the profile display name.
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the registration process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindRegistrationRequestFromBody(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.RegistrationRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); errors.Is(err, io.EOF) {
+		log.Printf("[HANDLER]: request body is empty, registering with the profile name")
+	} else if err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(registrationRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, registrationRequestKey)
+	return nil
+}
+
+// Function `deriveParticipantRecordFromRegistration` initializes a participant record referencing the account record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrRegistrationNameRequired` if neither the request nor the profile names the participant (or another error that occurred during
+//     this processing step)
+func deriveParticipantRecordFromRegistration(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.RegistrationRequest
+	var event models.EventRecord
+	var acct models.UserAccount
+	var player models.EventParticipant
+	var err error
+
+	log.Printf("[HANDLER]: loading request data from workspace under %q into variable of type %T...", registrationRequestKey, req)
+	if err = space.Get(registrationRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading request data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading account record from workspace under %q into variable of type %T...", userAccountRecordKey, acct)
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	player.DisplayName = req.DisplayName
+	if player.DisplayName == "" && acct.Profile != nil {
+		player.DisplayName = acct.Profile.DisplayName
+	}
+	if player.DisplayName == "" {
+		log.Printf("[HANDLER]: no participant name requested and account (_id=%q) has no display name", acct.ID.Hex())
+		return ErrRegistrationNameRequired
+	}
+
+	log.Printf("[HANDLER]: populating participant record...")
+	player.ID = bson.NewObjectID()
+	player.User = acct.ID
+	player.ParticipatesIn = event.ID
+	player.Metadata = dbx.InitialMetadata()
+
+	log.Printf("[HANDLER]: saved participant record to workspace under the %q key", participantRecordKey)
+	space.Set(participantRecordKey, player)
+	return nil
+}
+
+// Function `verifyNotRegistered` checks that the user of the participant record within the workspace is not yet a participant of the event
+//
+// Archived participant records still count: the unique registration index covers them, so the user is told why they cannot register again.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrAlreadyRegistered` if the user already participates in the event, `ErrRegistrationArchived` if their participant record was
+//     archived (or another error that occurred during this processing step)
+func verifyNotRegistered(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var player models.EventParticipant
+	var existing bson.Raw
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading record data from workspace under the %q key into variable of type %T...", participantRecordKey, player)
+	if err = space.Get(participantRecordKey, &player); err != nil {
+		log.Printf("[HANDLER]: error loading participant record data (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation (existing registration)")
+	filter := bson.D{{Key: "participates_in", Value: player.ParticipatesIn}, {Key: "user", Value: player.User}}
+	existing, err = sess.Client().
+		Database(models.ParticipantQueryContext.Database).
+		Collection(models.ParticipantQueryContext.Collection).
+		FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}, {Key: "metadata.active", Value: 1}})).
+		Raw()
+
+	if err == nil {
+		if flag, lookupErr := existing.LookupErr("metadata", "active"); lookupErr == nil {
+			if active, ok := flag.BooleanOK(); ok && !active {
+				log.Printf("[HANDLER]: user %q has an archived participant record in event (_id=%q)", player.User.Hex(), player.ParticipatesIn.Hex())
+				return ErrRegistrationArchived
+			}
+		}
+		log.Printf("[HANDLER]: user %q already participates in event (_id=%q)", player.User.Hex(), player.ParticipatesIn.Hex())
+		return ErrAlreadyRegistered
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: user is not yet registered")
+	return nil
+}
+
+// Function `removeRegistrationOfActiveUser` deletes the participant record referencing the user presented in the access token from the event
+// record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrNotRegistered` if the user does not participate in the event (or another error that occurred during this processing step)
+func removeRegistrationOfActiveUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var event models.EventRecord
+	var player models.EventParticipant
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading event record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: running database delete...")
+	filter := bson.D{{Key: "participates_in", Value: event.ID}, {Key: "user", Value: userid}}
+	err = sess.Client().
+		Database(models.ParticipantQueryContext.Database).
+		Collection(models.ParticipantQueryContext.Collection).
+		FindOneAndDelete(ctx, filter).
+		Decode(&player)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: user %q does not participate in event (_id=%q)", whoami, event.ID.Hex())
+		return ErrNotRegistered
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error during database delete operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(participatIDResponseKey, models.ParticipantID{EID: event.ID.Hex(), PID: player.ID.Hex()})
+	log.Printf("[HANDLER]: user %q withdrew from event (_id=%q)", whoami, event.ID.Hex())
+	return nil
+}
+
+// Function `ensureRegistrationIndexes` creates the unique index preventing a user from participating in the same event more than once
+//
+// The index also covers archived participant records (see `verifyNotRegistered`). The server refuses to start without it.
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the index (nil if no issue occurred)
+func ensureRegistrationIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.ParticipantQueryContext.Database).
+		Collection(models.ParticipantQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys: bson.D{{Key: "participates_in", Value: 1}, {Key: "user", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "user", Value: bson.D{{Key: "$exists", Value: true}}}}),
+		})
+	return err
+}
+
+// Function `isRegistrationError` determines if the given error is a refused registration or withdrawal
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isRegistrationError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrRegistrationClosed), errors.Is(e, ErrBracketGenerated), errors.Is(e, ErrAlreadyRegistered), errors.Is(e, ErrNotRegistered),
+		errors.Is(e, ErrRegistrationArchived):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("registration", e.Error()),
+		), true
+	case errors.Is(e, ErrRegistrationNameRequired):
+		return handlerutil.ErrUnprocessibleEntity(
+			handlerutil.NewDetail("name", e.Error()),
+		), true
+	}
+	return nil, false
+}
@@ -0,0 +1,279 @@
+package core
+
+/*
+ * File: pkg/core/registrations_test.go
+ *
+ * Purpose: unit tests for self-registration of users as event participants
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var openEventDoc = bson.M{
+	"_id":               bson.NewObjectID(),
+	"host":              bson.NewObjectID(),
+	"status":            models.StatusPlanned,
+	"name":              "Open Tournament",
+	"game":              "Rock-Paper-Scissors",
+	"open_registration": true,
+}
+
+var (
+	findOpenEventOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.events"},
+			{Key: "firstBatch", Value: bson.A{openEventDoc}},
+		}},
+	}
+	findNoParticipantOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.participants"},
+			{Key: "firstBatch", Value: bson.A{}},
+		}},
+	}
+	findArchivedParticipantOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.participants"},
+			{Key: "firstBatch", Value: bson.A{bson.M{
+				"_id":      bson.NewObjectID(),
+				"metadata": bson.M{"active": false},
+			}}},
+		}},
+	}
+	withdrawRegistrationOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 1}}},
+		{Key: "value", Value: findParticipantDoc[0]},
+	}
+	withdrawNoRegistrationOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 0}}},
+		{Key: "value", Value: nil},
+	}
+)
+
+func TestRegistrationPipeline(t *testing.T) {
+	eventURI := models.EventID{ID: openEventDoc["_id"].(bson.ObjectID).Hex()}
+
+	t.Run("RegisteredWithProfileName", func(t *testing.T) {
+		var result models.ParticipantID
+		var participant models.EventParticipant
+		pCtx, pCancel, pIn, pOut := registrationPipeline(setupWorkingAdminContext(t, findOpenEventOk, findNoMatchOk, findProfiledUserOk, findNoParticipantOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+		bindBodyTo(space, models.RegistrationRequest{})
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(participatIDResponseKey, &result))
+		require.NoError(t, after.Get(participantRecordKey, &participant))
+
+		assert.Equal(t, eventURI.ID, result.EID)
+		assert.Equal(t, participant.ID.Hex(), result.PID)
+		assert.Equal(t, "Test User", participant.DisplayName)
+		assert.Equal(t, profiledUserDoc["_id"], participant.User)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("ClosedEventRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := registrationPipeline(setupWorkingAdminContext(t, findEventOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, models.EventID{ID: findEventDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex()}, nil)
+		bindBodyTo(space, models.RegistrationRequest{})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrRegistrationClosed)
+	})
+
+	t.Run("GeneratedBracketRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := registrationPipeline(setupWorkingAdminContext(t, findOpenEventOk, findMatchOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+		bindBodyTo(space, models.RegistrationRequest{})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrBracketGenerated)
+	})
+
+	t.Run("DuplicateRegistrationRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := registrationPipeline(setupWorkingAdminContext(t, findOpenEventOk, findNoMatchOk, findProfiledUserOk, findParticipantOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+		bindBodyTo(space, models.RegistrationRequest{DisplayName: "Another Name"})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrAlreadyRegistered)
+	})
+
+	t.Run("ArchivedRegistrationRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := registrationPipeline(setupWorkingAdminContext(t, findOpenEventOk, findNoMatchOk, findProfiledUserOk, findArchivedParticipantOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+		bindBodyTo(space, models.RegistrationRequest{})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrRegistrationArchived)
+		_, classified := isRegistrationError(context.Cause(pCtx))
+		assert.True(t, classified)
+	})
+
+	t.Run("MissingNameRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := registrationPipeline(setupWorkingAdminContext(t, findOpenEventOk, findNoMatchOk, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+		bindBodyTo(space, models.RegistrationRequest{})
+		pIn <- space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrRegistrationNameRequired)
+	})
+}
+
+func TestRegistrationRequestBinding(t *testing.T) {
+	bind := func(t *testing.T, body io.Reader) (models.RegistrationRequest, error) {
+		t.Helper()
+		var req models.RegistrationRequest
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/", body)
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		space := handlerutil.DefaultWorkspace()
+		space.Set(handlerutil.RequestBindings, handlerutil.BindingsFromRequestContext(c, handlerutil.ShouldHaveJSONBody))
+		if err := bindRegistrationRequestFromBody(context.Background(), &space); err != nil {
+			return req, err
+		}
+		require.NoError(t, space.Get(registrationRequestKey, &req))
+		return req, nil
+	}
+
+	t.Run("EmptyBodyAccepted", func(t *testing.T) {
+		req, err := bind(t, nil)
+		require.NoError(t, err)
+		assert.Equal(t, models.RegistrationRequest{}, req)
+	})
+
+	t.Run("NameBound", func(t *testing.T) {
+		req, err := bind(t, strings.NewReader(`{"name": "Another Name"}`))
+		require.NoError(t, err)
+		assert.Equal(t, "Another Name", req.DisplayName)
+	})
+
+	t.Run("MalformedBodyRejected", func(t *testing.T) {
+		_, err := bind(t, strings.NewReader(`{"name":`))
+		assert.Error(t, err)
+	})
+}
+
+func TestWithdrawalPipeline(t *testing.T) {
+	eventURI := models.EventID{ID: openEventDoc["_id"].(bson.ObjectID).Hex()}
+
+	t.Run("Withdrawn", func(t *testing.T) {
+		var result models.ParticipantID
+		pCtx, pCancel, pIn, pOut := withdrawalPipeline(setupWorkingAdminContext(t, findOpenEventOk, findNoMatchOk, withdrawRegistrationOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(participatIDResponseKey, &result))
+		assert.Equal(t, eventURI.ID, result.EID)
+		assert.Equal(t, findParticipantDoc[0].(bson.M)["_id"].(bson.ObjectID).Hex(), result.PID)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("NotRegisteredRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := withdrawalPipeline(setupWorkingAdminContext(t, findOpenEventOk, findNoMatchOk, withdrawNoRegistrationOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrNotRegistered)
+	})
+
+	t.Run("GeneratedBracketRefused", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := withdrawalPipeline(setupWorkingAdminContext(t, findOpenEventOk, findMatchOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, eventURI, nil)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrBracketGenerated)
+	})
+}
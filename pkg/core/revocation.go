@@ -0,0 +1,491 @@
+package core
+
+/*
+ * File: pkg/core/revocation.go
+ *
+ * Purpose: access token revocation storage and the processing steps that revoke tokens
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Duration a revocation lookup is reused before the database is consulted again
+//
+// Revocations made by this process update its cache immediately, but other replicas keep honouring the revoked tokens until their cached lookup expires.
+const revocationCacheTTL = 30 * time.Second
+
+// Error returned when an access token presented by a client was revoked
+var ErrAccessTokenRevoked = errors.New("this token has been revoked")
+
+// Type `revocationCacheEntry` represents the last known revocation state of a scope
+//
+// Members:
+//   - revokedBefore: tokens of the scope issued before this timestamp are revoked (zero if the scope has no revocation)
+//   - exempt: the IDs of tokens issued in the last revoked second that remain valid
+//   - checkedAt: the timestamp the state was last read from or written to the database
+type revocationCacheEntry struct {
+	revokedBefore time.Time
+	exempt        []string
+	checkedAt     time.Time
+}
+
+// Function `(revocationCacheEntry).covers` determines whether the given token is revoked by this state
+//
+// Parameters:
+//   - token: the token to check
+//
+// Returns:
+//   - `bool`: whether the token was issued before the revocation time and is not exempt from it
+func (entry revocationCacheEntry) covers(token models.TokenIdentity) bool {
+	if entry.revokedBefore.IsZero() || !token.IssuedAt.Before(entry.revokedBefore) {
+		return false
+	}
+	if token.IssuedAt.Before(entry.revokedBefore.Add(-time.Second)) {
+		return true
+	}
+	return !slices.Contains(entry.exempt, token.ID)
+}
+
+// Type `mongoRevocationStore` implements `models.TokenRevocationStore` with a MongoDB collection and an in-process cache
+//
+// Members:
+//   - tokenTTL: the duration access tokens remain valid (revocations are kept at least this long)
+//   - mu: guards the cache
+//   - cache: the last known revocation state of each scope
+type mongoRevocationStore struct {
+	tokenTTL time.Duration
+	mu       sync.Mutex
+	cache    map[string]revocationCacheEntry
+}
+
+// Function `newMongoRevocationStore` creates a revocation store for access tokens valid for the given duration
+//
+// Parameters:
+//   - tokenTTL: the duration access tokens remain valid
+//
+// Returns:
+//   - `*mongoRevocationStore`: the revocation store
+func newMongoRevocationStore(tokenTTL time.Duration) *mongoRevocationStore {
+	return &mongoRevocationStore{
+		tokenTTL: tokenTTL,
+		cache:    make(map[string]revocationCacheEntry),
+	}
+}
+
+// Function `(*mongoRevocationStore).EnsureIndexes` creates the TTL index removing revocations once every token they cover has expired
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the index (nil if no issue occurred)
+func (store *mongoRevocationStore) EnsureIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.RevocationQueryContext.Database).
+		Collection(models.RevocationQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		})
+	return err
+}
+
+// Function `(*mongoRevocationStore).RevokeUser` implements `models.TokenRevocationStore.RevokeUser`
+func (store *mongoRevocationStore) RevokeUser(ctx context.Context, user string, at time.Time, exempt ...string) error {
+	before := revocationCutoff(at)
+	return store.revoke(ctx, "user:"+user, before, before.Add(store.tokenTTL), exempt)
+}
+
+// Function `(*mongoRevocationStore).RevokeSession` implements `models.TokenRevocationStore.RevokeSession`
+func (store *mongoRevocationStore) RevokeSession(ctx context.Context, family string, at time.Time, exempt ...string) error {
+	before := revocationCutoff(at)
+	return store.revoke(ctx, "session:"+family, before, before.Add(store.tokenTTL), exempt)
+}
+
+// Function `(*mongoRevocationStore).RevokeToken` implements `models.TokenRevocationStore.RevokeToken`
+//
+// Every token carrying the ID is issued before it expires, so the expiry doubles as the revocation time of the scope.
+func (store *mongoRevocationStore) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	return store.revoke(ctx, "token:"+id, expiresAt, expiresAt, nil)
+}
+
+// Function `(*mongoRevocationStore).IsRevoked` implements `models.TokenRevocationStore.IsRevoked`
+func (store *mongoRevocationStore) IsRevoked(ctx context.Context, token models.TokenIdentity) (bool, error) {
+	scopes := []string{"user:" + token.User}
+	if token.Session != "" {
+		scopes = append(scopes, "session:"+token.Session)
+	}
+	if token.ID != "" {
+		scopes = append(scopes, "token:"+token.ID)
+	}
+
+	states, err := store.lookup(ctx, scopes)
+	if err != nil {
+		return false, err
+	}
+
+	for _, state := range states {
+		if state.covers(token) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Function `revocationCutoff` determines the time tokens must be issued before to be covered by a revocation made at the given time
+//
+// The `iat` claim only has second precision, so the cutoff is rounded up to the next second to cover every token issued in the second of the revocation.
+//
+// Parameters:
+//   - at: the time of the revocation
+//
+// Returns:
+//   - `time.Time`: the revocation cutoff
+func revocationCutoff(at time.Time) time.Time {
+	return at.Truncate(time.Second).Add(time.Second)
+}
+
+// Function `(*mongoRevocationStore).revoke` records a revocation of the tokens of a scope
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - scope: the revoked scope
+//   - before: tokens of the scope issued before this timestamp are revoked
+//   - expiresAt: the timestamp after which every revoked token has expired
+//   - exempt: the IDs of tokens issued in the last revoked second that remain valid
+//
+// Returns:
+//   - `error`: issue recording the revocation (nil if no issue occurred)
+func (store *mongoRevocationStore) revoke(ctx context.Context, scope string, before time.Time, expiresAt time.Time, exempt []string) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := dbx.NewOptions(dbx.DoInsertOnNoMatchFound(true))
+	if err != nil {
+		return err
+	}
+
+	update := bson.D{{Key: "$max", Value: bson.D{
+		{Key: "revoked_before", Value: before},
+		{Key: "expires_at", Value: expiresAt},
+	}}}
+	if len(exempt) > 0 {
+		update = append(update, bson.E{Key: "$addToSet", Value: bson.D{{Key: "exempt", Value: bson.D{{Key: "$each", Value: exempt}}}}})
+	}
+
+	_, err = sess.Client().
+		Database(models.RevocationQueryContext.Database).
+		Collection(models.RevocationQueryContext.Collection).
+		UpdateOne(ctx, bson.D{{Key: "_id", Value: scope}}, update, cfg)
+	if err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	cached := store.cache[scope]
+	switch {
+	case before.After(cached.revokedBefore):
+		store.cache[scope] = revocationCacheEntry{revokedBefore: before, exempt: slices.Concat(cached.exempt, exempt), checkedAt: time.Now()}
+	case before.Equal(cached.revokedBefore):
+		cached.exempt = slices.Concat(cached.exempt, exempt)
+		store.cache[scope] = cached
+	}
+	return nil
+}
+
+// Function `(*mongoRevocationStore).lookup` determines the revocation state of the given scopes, consulting the database for scopes not cached recently
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - scopes: the scopes to determine the state of
+//
+// Returns:
+//   - `[]revocationCacheEntry`: the state of each scope
+//   - `error`: issue reading the database (nil if no issue occurred)
+func (store *mongoRevocationStore) lookup(ctx context.Context, scopes []string) ([]revocationCacheEntry, error) {
+	var stale []string
+	var records []models.TokenRevocation
+	var now = time.Now()
+
+	store.mu.Lock()
+	for _, scope := range scopes {
+		if cached, ok := store.cache[scope]; !ok || now.Sub(cached.checkedAt) > revocationCacheTTL {
+			stale = append(stale, scope)
+		}
+	}
+	store.mu.Unlock()
+
+	if len(stale) > 0 {
+		sess, err := dbx.MongoFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cur, err := sess.Client().
+			Database(models.RevocationQueryContext.Database).
+			Collection(models.RevocationQueryContext.Collection).
+			Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: stale}}}})
+		if err != nil {
+			return nil, err
+		}
+		if err = cur.All(ctx, &records); err != nil {
+			return nil, err
+		}
+
+		fresh := make(map[string]revocationCacheEntry, len(stale))
+		for _, scope := range stale {
+			fresh[scope] = revocationCacheEntry{checkedAt: now}
+		}
+		for _, record := range records {
+			fresh[record.ID] = revocationCacheEntry{revokedBefore: record.RevokedBefore, exempt: record.Exempt, checkedAt: now}
+		}
+
+		store.mu.Lock()
+		for scope, entry := range fresh {
+			if known := store.cache[scope]; known.revokedBefore.After(entry.revokedBefore) {
+				entry.revokedBefore, entry.exempt = known.revokedBefore, known.exempt
+			}
+			store.cache[scope] = entry
+		}
+		store.mu.Unlock()
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	states := make([]revocationCacheEntry, 0, len(scopes))
+	for _, scope := range scopes {
+		states = append(states, store.cache[scope])
+	}
+	return states, nil
+}
+
+// Function `isAccessTokenRevokedError` determines if the given error is a revoked access token
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isAccessTokenRevokedError(e error) (error, bool) {
+	if errors.Is(e, ErrAccessTokenRevoked) {
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("token", e.Error()),
+		), true
+	}
+	return nil, false
+}
+
+// Function `revokeTokensOf` creates a processing step that revokes the access tokens of the scope selected from the workspace
+//
+// The step does nothing when no revocation store is configured. An access token already minted by the same request is exempt from the revocation.
+//
+// Parameters:
+//   - selectScope: determines which tokens to revoke from the workspace contents
+//
+// Returns:
+//   - `func(context.Context, *handlerutil.HandlerWorkspace) error`: the processing step performing the revocation
+func revokeTokensOf(selectScope func(*handlerutil.HandlerWorkspace) (user string, family string, err error)) func(context.Context, *handlerutil.HandlerWorkspace) error {
+	return func(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+		var opts models.TokenOptions
+		var minted []string
+		var now = time.Now().UTC()
+
+		log.Printf("[HANDLER]: loading token options from workspace under %q key...", authTokenOptionsKey)
+		if err := space.Get(authTokenOptionsKey, &opts); err != nil {
+			log.Printf("[HANDLER]: error loading token options (%s)", err.Error())
+			return err
+		}
+
+		if opts.Revocations == nil {
+			log.Printf("[HANDLER]: no revocation store configured, skipping access token revocation")
+			return nil
+		}
+
+		user, family, err := selectScope(space)
+		if err != nil {
+			log.Printf("[HANDLER]: error determining access tokens to revoke (%s)", err.Error())
+			return err
+		}
+
+		var id string
+		if err := space.Get(accessTokenIDKey, &id); err == nil {
+			log.Printf("[HANDLER]: exempting access token (jti=%q) minted by this request from the revocation", id)
+			minted = append(minted, id)
+		} else if !errors.Is(err, handlerutil.ErrKeyNotExists) {
+			log.Printf("[HANDLER]: error loading minted access token ID (%s)", err.Error())
+			return err
+		}
+
+		if family != "" {
+			log.Printf("[HANDLER]: revoking access tokens of session family %q", family)
+			err = opts.Revocations.RevokeSession(ctx, family, now, minted...)
+		} else {
+			log.Printf("[HANDLER]: revoking access tokens of user %q", user)
+			err = opts.Revocations.RevokeUser(ctx, user, now, minted...)
+		}
+
+		if err != nil {
+			log.Printf("[HANDLER]: error revoking access tokens (%s)", err.Error())
+			return err
+		}
+		return nil
+	}
+}
+
+// Function `revokePresentedAccessToken` revokes the access token presented alongside the request by its `jti` claim
+//
+// Tokens that cannot be verified or were issued to another user are skipped, since they would not be accepted anyway or are not the caller's to revoke.
+// The step does nothing when no revocation store is configured.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func revokePresentedAccessToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var opts models.TokenOptions
+	var cur models.UserSession
+	var raw string
+	var publicClaims jwt.Claims
+	var privateClaims models.AuthorizationTokenClaims
+
+	log.Printf("[HANDLER]: loading token options from workspace under %q key...", authTokenOptionsKey)
+	if err := space.Get(authTokenOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading token options (%s)", err.Error())
+		return err
+	}
+
+	if opts.Revocations == nil {
+		log.Printf("[HANDLER]: no revocation store configured, skipping access token revocation")
+		return nil
+	}
+
+	log.Printf("[HANDLER]: loading session details from workspace...")
+	if err := space.Get(userSessionRecordKey, &cur); err != nil {
+		log.Printf("[HANDLER]: error loading session details (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading presented access token from workspace...")
+	if err := space.Get(activeAccessToken, &raw); err != nil {
+		log.Printf("[HANDLER]: error loading presented access token (%s)", err.Error())
+		return err
+	}
+
+	token, err := jwt.ParseSigned(raw, tokenAlgorithms(opts))
+	if err != nil {
+		log.Printf("[HANDLER]: presented access token could not be parsed, skipping its revocation (%s)", err.Error())
+		return nil
+	}
+	key, err := tokenVerificationKey(opts, token.Headers[0])
+	if err != nil {
+		log.Printf("[HANDLER]: no verification key for presented access token, skipping its revocation (%s)", err.Error())
+		return nil
+	}
+	if err = token.Claims(key, &publicClaims, &privateClaims); err != nil {
+		log.Printf("[HANDLER]: presented access token could not be verified, skipping its revocation (%s)", err.Error())
+		return nil
+	}
+
+	if publicClaims.ID == "" || publicClaims.Expiry == nil || privateClaims.Me != cur.Authorizes.Hex() {
+		log.Printf("[HANDLER]: presented access token does not belong to the closed session, skipping its revocation")
+		return nil
+	}
+
+	log.Printf("[HANDLER]: revoking presented access token (jti=%q)", publicClaims.ID)
+	if err = opts.Revocations.RevokeToken(ctx, publicClaims.ID, publicClaims.Expiry.Time()); err != nil {
+		log.Printf("[HANDLER]: error revoking presented access token (%s)", err.Error())
+		return err
+	}
+	return nil
+}
+
+// Function `sessionRecordTokenScope` selects the session family of the session record within the workspace for access token revocation
+//
+// Parameters:
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `string`: the user whose tokens are revoked (only used when the session has no family)
+//   - `string`: the session family whose tokens are revoked
+//   - `error`: issue reading the workspace (nil if no issue occurred)
+func sessionRecordTokenScope(space *handlerutil.HandlerWorkspace) (string, string, error) {
+	var cur models.UserSession
+	if err := space.Get(userSessionRecordKey, &cur); err != nil {
+		return "", "", err
+	}
+	if cur.Family.IsZero() {
+		return cur.Authorizes.Hex(), "", nil
+	}
+	return cur.Authorizes.Hex(), cur.Family.Hex(), nil
+}
+
+// Function `activeUserTokenScope` selects the session family requested within the workspace for access token revocation (or every token of the
+// user presented in the access token if no session family was requested)
+//
+// Parameters:
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `string`: the user whose tokens are revoked
+//   - `string`: the session family whose tokens are revoked (empty to revoke every token of the user)
+//   - `error`: issue reading the workspace (nil if no issue occurred)
+func activeUserTokenScope(space *handlerutil.HandlerWorkspace) (string, string, error) {
+	var whoami string
+	var lookup models.SessionFamilyID
+
+	if err := space.Get(activeUserID, &whoami); err != nil {
+		return "", "", err
+	}
+	if err := space.Get(sessionFamilyRequest, &lookup); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		return "", "", err
+	}
+	return whoami, lookup.ID, nil
+}
+
+// Function `moderatedAccountTokenScope` selects the moderated account within the workspace for access token revocation
+//
+// Parameters:
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `string`: the user whose tokens are revoked
+//   - `string`: always empty (every token of the user is revoked)
+//   - `error`: issue reading the workspace (nil if no issue occurred)
+func moderatedAccountTokenScope(space *handlerutil.HandlerWorkspace) (string, string, error) {
+	var acct models.UserAccount
+	if err := space.Get(moderatedAccountKey, &acct); err != nil {
+		return "", "", err
+	}
+	return acct.ID.Hex(), "", nil
+}
@@ -0,0 +1,210 @@
+package core
+
+/*
+ * File: pkg/core/revocation_test.go
+ *
+ * Purpose: unit tests for access token revocation
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func findRevocationOk(records ...bson.M) bson.D {
+	batch := bson.A{}
+	for _, record := range records {
+		batch = append(batch, record)
+	}
+
+	return bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.revoked_tokens"},
+			{Key: "firstBatch", Value: batch},
+		}},
+	}
+}
+
+func TestMongoRevocationStore(t *testing.T) {
+	t.Run("RevokedUserServedFromCache", func(t *testing.T) {
+		store := newMongoRevocationStore(5 * time.Minute)
+		ctx := setupWorkingAdminContext(t, updateOneOk)
+		user := bson.NewObjectID().Hex()
+		now := time.Now().UTC()
+
+		require.NoError(t, store.RevokeUser(ctx, user, now))
+
+		revoked, err := store.IsRevoked(ctx, models.TokenIdentity{User: user, IssuedAt: now.Add(-time.Minute)})
+		require.NoError(t, err)
+		assert.True(t, revoked, "Token issued before the revocation should be revoked")
+
+		revoked, err = store.IsRevoked(ctx, models.TokenIdentity{User: user, IssuedAt: now.Add(time.Minute)})
+		require.NoError(t, err)
+		assert.False(t, revoked, "Token issued after the revocation should remain valid")
+	})
+
+	t.Run("TokenIssuedInRevocationSecondRevoked", func(t *testing.T) {
+		store := newMongoRevocationStore(5 * time.Minute)
+		ctx := setupWorkingAdminContext(t, updateOneOk)
+		user := bson.NewObjectID().Hex()
+		at := time.Now().UTC().Truncate(time.Second).Add(300 * time.Millisecond)
+
+		require.NoError(t, store.RevokeUser(ctx, user, at))
+
+		revoked, err := store.IsRevoked(ctx, models.TokenIdentity{User: user, IssuedAt: at.Truncate(time.Second)})
+		require.NoError(t, err)
+		assert.True(t, revoked, "Token issued in the same second as the revocation should be revoked")
+
+		revoked, err = store.IsRevoked(ctx, models.TokenIdentity{User: user, IssuedAt: at.Truncate(time.Second).Add(time.Second)})
+		require.NoError(t, err)
+		assert.False(t, revoked, "Token issued in a later second should remain valid")
+	})
+
+	t.Run("MintedTokenExempt", func(t *testing.T) {
+		store := newMongoRevocationStore(5 * time.Minute)
+		ctx := setupWorkingAdminContext(t, updateOneOk, findRevocationOk(), findRevocationOk())
+		family := bson.NewObjectID().Hex()
+		at := time.Now().UTC()
+
+		require.NoError(t, store.RevokeSession(ctx, family, at, "mintedjti"))
+
+		revoked, err := store.IsRevoked(ctx, models.TokenIdentity{ID: "mintedjti", User: bson.NewObjectID().Hex(), Session: family, IssuedAt: at.Truncate(time.Second)})
+		require.NoError(t, err)
+		assert.False(t, revoked, "Token minted by the revoking request should remain valid")
+
+		revoked, err = store.IsRevoked(ctx, models.TokenIdentity{ID: "otherjti", User: bson.NewObjectID().Hex(), Session: family, IssuedAt: at.Truncate(time.Second)})
+		require.NoError(t, err)
+		assert.True(t, revoked, "Other tokens issued in the same second should be revoked")
+	})
+
+	t.Run("RevokedTokenServedFromCache", func(t *testing.T) {
+		store := newMongoRevocationStore(5 * time.Minute)
+		ctx := setupWorkingAdminContext(t, updateOneOk, findRevocationOk(), findRevocationOk())
+		issued := time.Now().UTC().Add(-time.Minute)
+
+		require.NoError(t, store.RevokeToken(ctx, "revokedjti", issued.Add(5*time.Minute)))
+
+		revoked, err := store.IsRevoked(ctx, models.TokenIdentity{ID: "revokedjti", User: bson.NewObjectID().Hex(), IssuedAt: issued})
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = store.IsRevoked(ctx, models.TokenIdentity{ID: "otherjti", User: bson.NewObjectID().Hex(), IssuedAt: issued})
+		require.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("RevokedSessionReadFromDatabase", func(t *testing.T) {
+		store := newMongoRevocationStore(5 * time.Minute)
+		family := bson.NewObjectID().Hex()
+		ctx := setupWorkingAdminContext(t, findRevocationOk(bson.M{
+			"_id":            "session:" + family,
+			"revoked_before": time.Now().UTC(),
+			"expires_at":     time.Now().UTC().Add(5 * time.Minute),
+		}))
+
+		revoked, err := store.IsRevoked(ctx, models.TokenIdentity{
+			User:     bson.NewObjectID().Hex(),
+			Session:  family,
+			IssuedAt: time.Now().UTC().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("UnrevokedTokenPasses", func(t *testing.T) {
+		store := newMongoRevocationStore(5 * time.Minute)
+		ctx := setupWorkingAdminContext(t, findRevocationOk())
+
+		revoked, err := store.IsRevoked(ctx, models.TokenIdentity{
+			User:     bson.NewObjectID().Hex(),
+			Session:  bson.NewObjectID().Hex(),
+			IssuedAt: time.Now().UTC(),
+		})
+		require.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
+
+func TestRevokedAccessTokenRejected(t *testing.T) {
+	store := newMongoRevocationStore(5 * time.Minute)
+	ctx := setupWorkingAdminContext(t, updateOneOk, findRevocationOk())
+	family := bson.NewObjectID().Hex()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(`1010101010101010101010101010101010101010101010101010101010101010`)}, nil)
+	require.NoError(t, err)
+	opts := models.TokenOptions{
+		Subject:     "testsubject",
+		Issuer:      "testissuer",
+		Signer:      signer,
+		Key:         `1010101010101010101010101010101010101010101010101010101010101010`,
+		Algorithm:   "HS256",
+		Revocations: store,
+	}
+
+	token, err := jwt.Signed(signer).
+		Claims(jwt.Claims{
+			ID:       "testjti",
+			Issuer:   opts.Issuer,
+			Subject:  opts.Subject,
+			IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}).
+		Claims(models.AuthorizationTokenClaims{Me: bson.NewObjectID().Hex(), Session: family}).
+		Serialize()
+	require.NoError(t, err)
+
+	require.NoError(t, store.RevokeSession(ctx, family, time.Now().UTC()))
+
+	err = validateAccessToken(ctx, setupKeyVerificationWorkspace(t, opts, token))
+	assert.ErrorIs(t, err, ErrAccessTokenRevoked)
+}
+
+func TestPresentedAccessTokenRevokedOnLogout(t *testing.T) {
+	store := newMongoRevocationStore(5 * time.Minute)
+	ctx := setupWorkingAdminContext(t, updateOneOk, findRevocationOk())
+	user := bson.NewObjectID()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(`1010101010101010101010101010101010101010101010101010101010101010`)}, nil)
+	require.NoError(t, err)
+	opts := models.TokenOptions{
+		Subject:     "testsubject",
+		Issuer:      "testissuer",
+		Signer:      signer,
+		Key:         `1010101010101010101010101010101010101010101010101010101010101010`,
+		Algorithm:   "HS256",
+		Revocations: store,
+	}
+
+	token, err := jwt.Signed(signer).
+		Claims(jwt.Claims{
+			ID:       "logoutjti",
+			Issuer:   opts.Issuer,
+			Subject:  opts.Subject,
+			IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}).
+		Claims(models.AuthorizationTokenClaims{Me: user.Hex()}).
+		Serialize()
+	require.NoError(t, err)
+
+	space := setupKeyVerificationWorkspace(t, opts, token)
+	space.Set(userSessionRecordKey, models.UserSession{ID: "testsession", Authorizes: user})
+	require.NoError(t, revokePresentedAccessToken(ctx, space))
+
+	err = validateAccessToken(ctx, setupKeyVerificationWorkspace(t, opts, token))
+	assert.ErrorIs(t, err, ErrAccessTokenRevoked)
+}
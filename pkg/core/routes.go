@@ -35,11 +35,15 @@ func (srv *tournabyteAPIService) addGlobalMiddleware() {
 func (srv *tournabyteAPIService) registerRoutes() {
 	srv.addGlobalMiddleware()
 
+	// GET /.well-known/jwks.json
+	srv.router.GET("/.well-known/jwks.json", srv.serveKeySet)
+
 	{
 		// /v1/...
 		v1 := srv.router.Group("v1")
 		srv.addAuthGroup(v1)
 		srv.addEventGroup(v1)
+		srv.addAdminGroup(v1)
 	}
 }
 
@@ -73,6 +77,21 @@ func (srv *tournabyteAPIService) addAuthGroup(parentGroup *gin.RouterGroup) {
 		handlerutil.HandlerTemplate(
 			srv.initAuthWorkspace,
 			userAuthenticationPipeline,
+			handlerutil.AwaitAndRespondAs[any],
+			http.StatusOK,
+			userAuthorizationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/tokens/mfa
+	authGroup.POST(
+		"/tokens/mfa",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAuthWorkspace,
+			mfaVerificationPipeline,
 			handlerutil.AwaitAndRespondAs[models.AuthenticatedUser],
 			http.StatusOK,
 			userAuthorizationResponseKey,
@@ -80,6 +99,35 @@ func (srv *tournabyteAPIService) addAuthGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// GET /v1/users/oidc/{provider}
+	authGroup.GET(
+		"/oidc/:provider",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initOIDCWorkspace,
+			oidcAuthorizationPipeline,
+			handlerutil.AwaitAndRespondAs[models.OIDCAuthorization],
+			http.StatusOK,
+			oidcAuthorizationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/oidc/{provider}/tokens
+	authGroup.POST(
+		"/oidc/:provider/tokens",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initOIDCWorkspace,
+			oidcCallbackPipeline,
+			handlerutil.AwaitAndRespondAs[any],
+			http.StatusOK,
+			userAuthorizationResponseKey,
+			srv.errfmt,
+		),
+	)
+
 	// PUT /v1/users/tokens
 	authGroup.PUT(
 		"/tokens",
@@ -110,6 +158,315 @@ func (srv *tournabyteAPIService) addAuthGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// GET /v1/users/me
+	authGroup.GET(
+		"/me",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initProfileWorkspace,
+			ownProfilePipeline,
+			handlerutil.AwaitAndRespondAs[models.OwnProfile],
+			http.StatusOK,
+			profileResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// PATCH /v1/users/me
+	authGroup.PATCH(
+		"/me",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initProfileWorkspace,
+			profileUpdatePipeline,
+			handlerutil.AwaitAndRespondAs[models.OwnProfile],
+			http.StatusOK,
+			profileResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// GET /v1/users/{id}
+	authGroup.GET(
+		"/:userid",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initProfileWorkspace,
+			publicProfilePipeline,
+			handlerutil.AwaitAndRespondAs[models.PublicProfile],
+			http.StatusOK,
+			profileResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// GET /v1/users/me/sessions
+	authGroup.GET(
+		"/me/sessions",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initSessionManagementWorkspace,
+			listSessionsPipeline,
+			handlerutil.AwaitAndRespondAs[[]models.ActiveSession],
+			http.StatusOK,
+			activeSessionListKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/users/me/sessions
+	authGroup.DELETE(
+		"/me/sessions",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initSessionManagementWorkspace,
+			revokeAllSessionsPipeline,
+			handlerutil.AwaitAndRespondAs[models.SessionRevocationResponse],
+			http.StatusOK,
+			sessionRevocationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/users/me/sessions/{id}
+	authGroup.DELETE(
+		"/me/sessions/:sessionid",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initSessionManagementWorkspace,
+			revokeSessionPipeline,
+			handlerutil.AwaitAndRespondAs[models.SessionRevocationResponse],
+			http.StatusOK,
+			sessionRevocationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/verify
+	authGroup.POST(
+		"/verify",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEmailVerificationWorkspace,
+			emailVerificationPipeline,
+			handlerutil.AwaitAndRespondAs[gin.H],
+			http.StatusOK,
+			emailVerificationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/me/verification
+	authGroup.POST(
+		"/me/verification",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEmailVerificationWorkspace,
+			resendEmailVerificationPipeline,
+			handlerutil.AwaitAndRespondAs[gin.H],
+			http.StatusAccepted,
+			emailVerificationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/me/mfa
+	authGroup.POST(
+		"/me/mfa",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initMFAWorkspace,
+			mfaEnrollmentPipeline,
+			handlerutil.AwaitAndRespondAs[models.MFAEnrollment],
+			http.StatusOK,
+			mfaResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// PUT /v1/users/me/mfa
+	authGroup.PUT(
+		"/me/mfa",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initMFAWorkspace,
+			mfaConfirmationPipeline,
+			handlerutil.AwaitAndRespondAs[models.MFARecoveryCodes],
+			http.StatusOK,
+			mfaResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/users/me/mfa
+	authGroup.DELETE(
+		"/me/mfa",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initMFAWorkspace,
+			mfaRemovalPipeline,
+			handlerutil.AwaitAndRespondAs[gin.H],
+			http.StatusOK,
+			mfaResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/me/keys
+	authGroup.POST(
+		"/me/keys",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAPIKeyWorkspace,
+			apiKeyCreationPipeline,
+			handlerutil.AwaitAndRespondAs[models.IssuedAPIKey],
+			http.StatusCreated,
+			apiKeyResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// GET /v1/users/me/keys
+	authGroup.GET(
+		"/me/keys",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initAPIKeyWorkspace,
+			listAPIKeysPipeline,
+			handlerutil.AwaitAndRespondAs[[]models.APIKeyRecord],
+			http.StatusOK,
+			apiKeyResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/users/me/keys/{id}
+	authGroup.DELETE(
+		"/me/keys/:keyid",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAPIKeyWorkspace,
+			revokeAPIKeyPipeline,
+			handlerutil.AwaitAndRespondAs[gin.H],
+			http.StatusOK,
+			apiKeyResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/me/exports
+	authGroup.POST(
+		"/me/exports",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initPrivacyWorkspace,
+			dataExportRequestPipeline,
+			handlerutil.AwaitAndRespondAs[models.DataExportStatus],
+			http.StatusAccepted,
+			dataExportResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// GET /v1/users/me/exports/{id}
+	authGroup.GET(
+		"/me/exports/:exportid",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initPrivacyWorkspace,
+			dataExportLookupPipeline,
+			handlerutil.AwaitAndRespondAs[models.DataExportStatus],
+			http.StatusOK,
+			dataExportResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/users/me
+	authGroup.DELETE(
+		"/me",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initPrivacyWorkspace,
+			accountDeletionPipeline,
+			handlerutil.AwaitAndRespondAs[models.AccountDeletionResponse],
+			http.StatusOK,
+			accountDeletionResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// PUT /v1/users/me/password
+	authGroup.PUT(
+		"/me/password",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initPasswordChangeWorkspace,
+			passwordChangePipeline,
+			handlerutil.AwaitAndRespondAs[models.SessionRevocationResponse],
+			http.StatusOK,
+			sessionRevocationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/users/password-resets
+	authGroup.POST(
+		"/password-resets",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initPasswordResetWorkspace,
+			passwordResetRequestPipeline,
+			handlerutil.AwaitAndRespondAs[gin.H],
+			http.StatusAccepted,
+			passwordResetResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// PUT /v1/users/password-resets
+	authGroup.PUT(
+		"/password-resets",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initPasswordResetWorkspace,
+			passwordResetRedemptionPipeline,
+			handlerutil.AwaitAndRespondAs[models.SessionRevocationResponse],
+			http.StatusOK,
+			sessionRevocationResponseKey,
+			srv.errfmt,
+		),
+	)
+
 }
 
 // Function `(*tournabyteAPIService).addEventGroup` configures the `gin.Engine` instance with event management related endpoints
@@ -134,6 +491,20 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// GET /v1/events
+	eventGroup.GET(
+		"/",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initEventListWorkspace,
+			eventListPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventListResponse],
+			http.StatusOK,
+			eventListResponseKey,
+			srv.errfmt,
+		),
+	)
+
 	// GET /v1/events/{id}
 	eventGroup.GET(
 		"/:eventid",
@@ -163,17 +534,121 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// POST /v1/events/{id}/start
+	eventGroup.POST(
+		"/:eventid/start",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			startEventPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventID],
+			http.StatusOK,
+			eventIDResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/events/{id}/conclude
+	eventGroup.POST(
+		"/:eventid/conclude",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			concludeEventPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventID],
+			http.StatusOK,
+			eventIDResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/events/{id}/cancel
+	eventGroup.POST(
+		"/:eventid/cancel",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			cancelEventPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventID],
+			http.StatusOK,
+			eventIDResponseKey,
+			srv.errfmt,
+		),
+	)
+
 	// DELETE /v1/events/{id}
 	eventGroup.DELETE(
 		"/:eventid",
 		srv.withMongoSession,
 		srv.withMongoTransaction,
 		handlerutil.HandlerTemplate(
-			srv.initEventLookupWorkspace,
+			srv.initEventRemovalWorkspace,
 			eventDeletionPipeline,
-			handlerutil.AwaitAndRespondAs[models.EventID],
+			handlerutil.AwaitAndRespondAs[models.EventRemovalResponse],
 			http.StatusOK,
-			eventIDResponseKey,
+			eventRemovalResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// GET /v1/events/{id}/staff
+	eventGroup.GET(
+		"/:eventid/staff",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			listStaffPipeline,
+			handlerutil.AwaitAndRespondAs[[]models.EventStaffMember],
+			http.StatusOK,
+			staffListKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/events/{id}/staff
+	eventGroup.POST(
+		"/:eventid/staff",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventUpdateWorkspace,
+			addStaffMemberPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventStaffMember],
+			http.StatusCreated,
+			staffMemberKey,
+			srv.errfmt,
+		),
+	)
+
+	// PATCH /v1/events/{id}/staff/{id}
+	eventGroup.PATCH(
+		"/:eventid/staff/:userid",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventUpdateWorkspace,
+			changeStaffRolePipeline,
+			handlerutil.AwaitAndRespondAs[models.EventStaffMember],
+			http.StatusOK,
+			staffMemberKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/events/{id}/staff/{id}
+	eventGroup.DELETE(
+		"/:eventid/staff/:userid",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			removeStaffMemberPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventStaffMember],
+			http.StatusOK,
+			staffMemberKey,
 			srv.errfmt,
 		),
 	)
@@ -193,6 +668,38 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// POST /v1/events/{id}/registrations
+	eventGroup.POST(
+		"/:eventid/registrations",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initParticipantCreationWorkspace,
+			registrationPipeline,
+			handlerutil.AwaitAndRespondAs[models.ParticipantID],
+			http.StatusCreated,
+			participatIDResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/events/{id}/registrations
+	eventGroup.DELETE(
+		"/:eventid/registrations",
+		srv.withoutAPIKeys,
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			withdrawalPipeline,
+			handlerutil.AwaitAndRespondAs[models.ParticipantID],
+			http.StatusOK,
+			participatIDResponseKey,
+			srv.errfmt,
+		),
+	)
+
 	// GET /v1/events/{id}/participants
 	eventGroup.GET(
 		"/:eventid/participants",
@@ -257,7 +764,7 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 		srv.withMongoSession,
 		srv.withMongoTransaction,
 		handlerutil.HandlerTemplate(
-			srv.initEventLookupWorkspace,
+			srv.initMatchSetCreationWorkspace,
 			createMatchSetPipeline,
 			handlerutil.AwaitAndRespondAs[models.EventRecord],
 			http.StatusCreated,
@@ -296,6 +803,35 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// POST /v1/events/{id}/rounds
+	eventGroup.POST(
+		"/:eventid/rounds",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			createSwissRoundPipeline,
+			handlerutil.AwaitAndRespondAs[[]models.EventMatch],
+			http.StatusCreated,
+			matchListRecordKey,
+			srv.errfmt,
+		),
+	)
+
+	// GET /v1/events/{id}/standings
+	eventGroup.GET(
+		"/:eventid/standings",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initEventLookupWorkspace,
+			getStandingsPipeline,
+			handlerutil.AwaitAndRespondAs[[]models.EventStanding],
+			http.StatusOK,
+			eventStandingsKey,
+			srv.errfmt,
+		),
+	)
+
 	// PATCH /v1/events/{id}/matches/{id}/away-participant
 	eventGroup.PATCH(
 		"/:eventid/matches/:matchid/away-participant",
@@ -326,6 +862,21 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 		),
 	)
 
+	// PATCH /v1/events/{id}/matches/{id}/score
+	eventGroup.PATCH(
+		"/:eventid/matches/:matchid/score",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initMatchUpdateWorkspace,
+			submitMatchScorePipeline,
+			handlerutil.AwaitAndRespondAs[models.EventMatch],
+			http.StatusOK,
+			matchRecordKey,
+			srv.errfmt,
+		),
+	)
+
 	// PATCH /v1/events/{id}/matches/{id}/declared-winner
 	eventGroup.PATCH(
 		"/:eventid/matches/:matchid/declared-winner",
@@ -340,4 +891,116 @@ func (srv *tournabyteAPIService) addEventGroup(parentGroup *gin.RouterGroup) {
 			srv.errfmt,
 		),
 	)
+
+	// PUT /v1/events/{id}/matches/{id}/declared-winner
+	eventGroup.PUT(
+		"/:eventid/matches/:matchid/declared-winner",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initMatchUpdateWorkspace,
+			reviseMatchResultPipeline,
+			handlerutil.AwaitAndRespondAs[models.MatchRevisionResponse],
+			http.StatusOK,
+			matchRevisionResponseKey,
+			srv.errfmt,
+		),
+	)
+}
+
+// Function `(*tournabyteAPIService).addAdminGroup` configures the `gin.Engine` instance with platform administration and moderation endpoints
+//
+// Parameters:
+//   - parentGroup: the parent portion of the API endpoint these handlers will be attached to
+func (srv *tournabyteAPIService) addAdminGroup(parentGroup *gin.RouterGroup) {
+	adminGroup := parentGroup.Group("admin")
+
+	// GET /v1/admin/users
+	adminGroup.GET(
+		"/users",
+		srv.withMongoSession,
+		handlerutil.HandlerTemplate(
+			srv.initAdminWorkspace,
+			listUsersPipeline,
+			handlerutil.AwaitAndRespondAs[models.UserListResponse],
+			http.StatusOK,
+			userListResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/admin/users/{id}/deactivate
+	adminGroup.POST(
+		"/users/:userid/deactivate",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAdminWorkspace,
+			deactivateUserPipeline,
+			handlerutil.AwaitAndRespondAs[models.AccountModerationResponse],
+			http.StatusOK,
+			moderationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/admin/users/{id}/reactivate
+	adminGroup.POST(
+		"/users/:userid/reactivate",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAdminWorkspace,
+			reactivateUserPipeline,
+			handlerutil.AwaitAndRespondAs[models.AccountModerationResponse],
+			http.StatusOK,
+			moderationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/admin/users/{id}/sessions
+	adminGroup.DELETE(
+		"/users/:userid/sessions",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAdminWorkspace,
+			closeUserSessionsPipeline,
+			handlerutil.AwaitAndRespondAs[models.AccountModerationResponse],
+			http.StatusOK,
+			moderationResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// POST /v1/admin/events/{id}/takeover
+	adminGroup.POST(
+		"/events/:eventid/takeover",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAdminWorkspace,
+			takeOverEventPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventID],
+			http.StatusOK,
+			eventIDResponseKey,
+			srv.errfmt,
+		),
+	)
+
+	// DELETE /v1/admin/events/{id}
+	adminGroup.DELETE(
+		"/events/:eventid",
+		srv.withMongoSession,
+		srv.withMongoTransaction,
+		handlerutil.HandlerTemplate(
+			srv.initAdminWorkspace,
+			adminEventDeletionPipeline,
+			handlerutil.AwaitAndRespondAs[models.EventRemovalResponse],
+			http.StatusOK,
+			eventRemovalResponseKey,
+			srv.errfmt,
+		),
+	)
 }
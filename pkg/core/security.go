@@ -0,0 +1,124 @@
+package core
+
+/*
+ * File: pkg/core/security.go
+ *
+ * Purpose: session family revocation and security event logging
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Function `detachedSession` starts a database session independent of the one within the given context
+//
+// Writes made through the returned context are not part of any transaction running in the request, so they persist even when the request
+// transaction is rolled back.
+//
+// Parameters:
+//   - ctx: the request context holding the database session
+//
+// Returns:
+//   - `context.Context`: the context holding the independent session
+//   - `func()`: function ending the independent session
+//   - `error`: issue starting the independent session (nil if no issue occurred)
+func detachedSession(ctx context.Context) (context.Context, func(), error) {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	detached, err := sess.Client().StartSession()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mongo.NewSessionContext(ctx, detached), func() { detached.EndSession(ctx) }, nil
+}
+
+// Function `revokeSessionFamily` removes every session descended from the same login as the given session and records the revocation to the
+// security log
+//
+// The revocation is performed outside of the request transaction so that it survives the request failing.
+//
+// Parameters:
+//   - ctx: the request context holding the database session
+//   - reused: the rotated session that was presented again
+//
+// Returns:
+//   - `error`: issue performing the revocation (nil if no issue occurred)
+func revokeSessionFamily(ctx context.Context, reused models.UserSession) error {
+	detachedCtx, end, err := detachedSession(ctx)
+	if err != nil {
+		log.Printf("[HANDLER]: error starting independent database session (%s)", err.Error())
+		return err
+	}
+	defer end()
+
+	sess, _ := dbx.MongoFromContext(detachedCtx)
+
+	filter := bson.D{{Key: "family", Value: reused.Family}}
+	if reused.Family.IsZero() {
+		filter = bson.D{{Key: "_id", Value: reused.ID}}
+	}
+
+	log.Printf("[HANDLER]: revoking session family (family=%q)", reused.Family.Hex())
+	res, err := sess.Client().
+		Database(models.UserSessionQueryContext.Database).
+		Collection(models.UserSessionQueryContext.Collection).
+		DeleteMany(detachedCtx, filter)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database deletion (%s)", err.Error())
+		return err
+	}
+
+	entry := models.SecurityLogEntry{
+		ID:         bson.NewObjectID(),
+		Kind:       models.SecurityEventRefreshTokenReuse,
+		User:       reused.Authorizes,
+		Family:     reused.Family,
+		Detail:     fmt.Sprintf("rotated refresh token presented again; %d sessions revoked", res.DeletedCount),
+		RecordedAt: time.Now().UTC(),
+	}
+
+	log.Printf("[HANDLER]: recording security event (kind=%q, user=%q)", entry.Kind, entry.User.Hex())
+	if _, err = sess.Client().
+		Database(models.SecurityLogQueryContext.Database).
+		Collection(models.SecurityLogQueryContext.Collection).
+		InsertOne(detachedCtx, entry); err != nil {
+		log.Printf("[HANDLER]: error performing database insertion (%s)", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Function `refreshTokenReused` handles a rotated refresh token being presented again by revoking its session family
+//
+// Parameters:
+//   - ctx: the request context holding the database session
+//   - reused: the rotated session that was presented again
+//
+// Returns:
+//   - `error`: `ErrRefreshTokenAlreadyUsed` (joined with any issue performing the revocation)
+func refreshTokenReused(ctx context.Context, reused models.UserSession) error {
+	if err := revokeSessionFamily(ctx, reused); err != nil {
+		return errors.Join(ErrRefreshTokenAlreadyUsed, err)
+	}
+	return ErrRefreshTokenAlreadyUsed
+}
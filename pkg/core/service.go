@@ -30,6 +30,7 @@ import (
 	"github.com/tournabyte/webapi/pkg/dbx"
 	"github.com/tournabyte/webapi/pkg/handlerutil"
 	"github.com/tournabyte/webapi/pkg/models"
+	"github.com/tournabyte/webapi/pkg/notify"
 )
 
 // Function `mongoClientFromConfig` creates the connection configured by the given application configuration
@@ -65,7 +66,7 @@ func minioClientFromConfig(cfg *models.ApplicationOptions) (*dbx.MinioConnection
 	)
 }
 
-// Function `tokenSignerFromConfig` creates a token signer based on the tokens options in the given application configuration
+// Function `tokenSignerFromConfig` creates a token signer from the shared secret in the given application configuration
 //
 // Parameters:
 //   - cfg: the application configuration to extract token options from
@@ -83,6 +84,57 @@ func tokenSignerFromConfig(cfg *models.ApplicationOptions) (jose.Signer, error)
 	)
 }
 
+// Function `notifierFromConfig` creates the account notification delivery channel configured by the given application configuration
+//
+// Notifications are written to the sink file (or the service log when no sink file is set) when no mail server is configured.
+//
+// Parameters:
+//   - cfg: the application configuration to extract notification options from
+//
+// Returns:
+//   - `notify.Notifier`: the notification delivery channel
+func notifierFromConfig(cfg *models.ApplicationOptions) notify.Notifier {
+	opts := cfg.Serve.Notifications
+	switch {
+	case opts.SMTPHost == "" && opts.SinkFile != "":
+		return notify.NewFileNotifier(opts.SinkFile)
+	case opts.SMTPHost == "":
+		return notify.NewLogNotifier(nil)
+	}
+	return notify.NewSMTPNotifier(opts.SMTPHost, opts.SMTPPort, opts.Sender, opts.Username, opts.Password)
+}
+
+// Function `loginThrottleFromConfig` creates the failed login tracker configured by the given application configuration
+//
+// Parameters:
+//   - cfg: the application configuration to extract login throttling options from
+//
+// Returns:
+//   - `*mongoLoginThrottle`: the failed login tracker
+func loginThrottleFromConfig(cfg *models.ApplicationOptions) *mongoLoginThrottle {
+	opts := cfg.Serve.LoginThrottle
+	return newMongoLoginThrottle(opts.AccountFailures, opts.ClientFailures, opts.Lockout, opts.MaxLockout, opts.Window)
+}
+
+// Function `routerFromConfig` creates the request router configured by the given application configuration
+//
+// Forwarding headers (e.g. `X-Forwarded-For`) are only honoured when the request comes from one of the configured trusted proxies; otherwise the client
+// address is the remote address of the connection, so clients cannot choose the address their failed logins are counted against.
+//
+// Parameters:
+//   - cfg: the application configuration to extract the trusted proxies from
+//
+// Returns:
+//   - `*gin.Engine`: the request router
+//   - `error`: issue with the trusted proxy list (e.g. an invalid address)
+func routerFromConfig(cfg *models.ApplicationOptions) (*gin.Engine, error) {
+	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.Serve.Security.TrustedProxies); err != nil {
+		return nil, err
+	}
+	return router, nil
+}
+
 // Function `initLogs` initializes structured logging for the server
 //
 // Parameters:
@@ -139,6 +191,25 @@ func parseLogOutputs(outputs ...string) ([]io.Writer, error) {
 func initErrorFormatter() *handlerutil.HandlerFailureFormatter {
 	ffmt := handlerutil.FailureFormatter(
 		dbx.IsDuplicateKeyError,
+		isStatusTransitionError,
+		isMatchResultError,
+		isSwissRoundError,
+		isSeedingError,
+		isInvalidCursorError,
+		isPermissionError,
+		isStaffError,
+		isAdminRoleRequiredError,
+		isSelfDeactivationError,
+		isAccessTokenRevokedError,
+		isAPIKeyError,
+		isPasswordCredentialError,
+		isEmailVerificationError,
+		isMFAError,
+		isOIDCError,
+		isProfileError,
+		isPrivacyError,
+		isRegistrationError,
+		isLoginFailureError,
 	)
 	return &ffmt
 }
@@ -150,6 +221,12 @@ func initErrorFormatter() *handlerutil.HandlerFailureFormatter {
 //   - db: the ephemeral database connection to a mongodb deployment
 //   - s3: the ephemeral s3 connection to a minio deployment
 //   - sess: the JWT signing tool for authorization checks
+//   - keys: the public keys verifying issued JWTs (nil when signing with a shared secret)
+//   - revocations: the store of revoked JWTs checked during authorization
+//   - throttle: the tracker of failed logins consulted during authentication
+//   - notifier: the delivery channel for account notifications
+//   - providers: the external identity providers users can log in with (by name)
+//   - exporter: the background generator and storage of personal data archives
 //   - validationFunc: the ephemeral validator for struct validation
 //   - opts: the API configuration options for the API server
 type tournabyteAPIService struct {
@@ -158,6 +235,12 @@ type tournabyteAPIService struct {
 	db             *dbx.MongoConnection
 	s3             *dbx.MinioConnection
 	sess           jose.Signer
+	keys           *jose.JSONWebKeySet
+	revocations    *mongoRevocationStore
+	throttle       *mongoLoginThrottle
+	notifier       notify.Notifier
+	providers      map[string]*oidcProvider
+	exporter       models.DataExporter
 	validationFunc *validator.Validate
 	opts           *models.ApplicationOptions
 }
@@ -174,7 +257,8 @@ func NewTournabyteService(options *models.ApplicationOptions) (*tournabyteAPISer
 	loggerErr := initLogs(options)
 	db, dbErr := mongoClientFromConfig(options)
 	s3, s3Err := minioClientFromConfig(options)
-	jwt, jwtErr := tokenSignerFromConfig(options)
+	jwt, keys, jwtErr := tokenKeysFromConfig(options)
+	router, routerErr := routerFromConfig(options)
 
 	if loggerErr != nil {
 		log.Printf("Could not setup service logger: %s", loggerErr.Error())
@@ -195,12 +279,23 @@ func NewTournabyteService(options *models.ApplicationOptions) (*tournabyteAPISer
 		return nil, jwtErr
 	}
 
+	if routerErr != nil {
+		log.Printf("Could not configure the trusted proxies: %s\n", routerErr.Error())
+		return nil, routerErr
+	}
+
 	return &tournabyteAPIService{
-		router:         gin.New(),
+		router:         router,
 		errfmt:         initErrorFormatter(),
 		db:             db,
 		s3:             s3,
 		sess:           jwt,
+		keys:           keys,
+		revocations:    newMongoRevocationStore(options.Serve.Sessions.AccessTokenTTL),
+		throttle:       loginThrottleFromConfig(options),
+		notifier:       notifierFromConfig(options),
+		providers:      identityProvidersFromConfig(options),
+		exporter:       newMinioDataExporter(s3, options.ObjectStore.ExportBucket, options.ObjectStore.ExportLinkTTL),
 		validationFunc: validator.New(),
 		opts:           options,
 	}, nil
@@ -210,9 +305,12 @@ func NewTournabyteService(options *models.ApplicationOptions) (*tournabyteAPISer
 // Function `(*TournabyteAPIService).Run` starts the server instance in a separate goroutine and enables graceful shutdowns of the system
 //
 // Returns:
-//   - `error`: issue that occurred during server shutdown
+//   - `error`: issue preparing the database indexes or that occurred during server shutdown
 func (srv *tournabyteAPIService) Run() error {
 	srv.registerRoutes()
+	if err := srv.prepareIndexes(); err != nil {
+		return err
+	}
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", srv.opts.Serve.Port),
 		Handler: srv.router,
@@ -272,11 +370,108 @@ func (srv *tournabyteAPIService) getSessionConfig() models.SessionOptions {
 //   - `models.TokenOptions`: a structure housing information specific to access token generation and validation
 func (srv *tournabyteAPIService) getTokenConfig() models.TokenOptions {
 	return models.TokenOptions{
-		Signer:    srv.sess,
-		Subject:   srv.opts.Serve.Sessions.Subject,
-		Issuer:    srv.opts.Serve.Sessions.Issuer,
-		ExpiresIn: srv.opts.Serve.Sessions.AccessTokenTTL,
-		Algorithm: srv.opts.Serve.Sessions.Algorithm,
-		Key:       srv.opts.Serve.Sessions.SigningKey,
+		Signer:      srv.sess,
+		Subject:     srv.opts.Serve.Sessions.Subject,
+		Issuer:      srv.opts.Serve.Sessions.Issuer,
+		ExpiresIn:   srv.opts.Serve.Sessions.AccessTokenTTL,
+		Algorithm:   srv.opts.Serve.Sessions.Algorithm,
+		Key:         srv.opts.Serve.Sessions.SigningKey,
+		Keyset:      srv.keys,
+		Revocations: srv.revocations,
+	}
+}
+
+// Function `(*tournabyteAPIService).getPasswordResetConfig` isolates the password reset specific options from the service options
+//
+// Returns:
+//   - `models.PasswordResetOptions`: a structure housing information specific to password reset token generation and delivery
+func (srv *tournabyteAPIService) getPasswordResetConfig() models.PasswordResetOptions {
+	return models.PasswordResetOptions{
+		ExpiresIn: srv.opts.Serve.Sessions.PasswordResetTTL,
+		Notifier:  srv.notifier,
+	}
+}
+
+// Function `(*tournabyteAPIService).getEmailVerificationConfig` isolates the email verification specific options from the service options
+//
+// Returns:
+//   - `models.EmailVerificationOptions`: a structure housing information specific to email verification token generation and delivery
+func (srv *tournabyteAPIService) getEmailVerificationConfig() models.EmailVerificationOptions {
+	return models.EmailVerificationOptions{
+		ExpiresIn: srv.opts.Serve.Sessions.EmailVerificationTTL,
+		Notifier:  srv.notifier,
+	}
+}
+
+// Function `(*tournabyteAPIService).getMFAConfig` isolates the two-factor authentication specific options from the service options
+//
+// Returns:
+//   - `models.MFAOptions`: a structure housing information specific to login challenges of accounts with two-factor authentication
+func (srv *tournabyteAPIService) getMFAConfig() models.MFAOptions {
+	return models.MFAOptions{
+		ChallengeExpiresIn: srv.opts.Serve.Sessions.MFAChallengeTTL,
+	}
+}
+
+// Function `(*tournabyteAPIService).getDataExportConfig` isolates the personal data export specific options from the service options
+//
+// Returns:
+//   - `models.DataExportOptions`: a structure housing information specific to personal data export generation and download
+func (srv *tournabyteAPIService) getDataExportConfig() models.DataExportOptions {
+	return models.DataExportOptions{
+		ExpiresIn: srv.opts.ObjectStore.ExportTTL,
+		Exporter:  srv.exporter,
+	}
+}
+
+// Function `(*tournabyteAPIService).prepareIndexes` creates the TTL indexes removing expired token revocations, password reset tokens,
+// email verification tokens, forgotten login attempts, expired login challenges, expired API keys, expired external logins and
+// expired data exports (along with the index looking up accounts by linked external identity and the index preventing a user from
+// registering for the same event twice)
+//
+// Failure to create a TTL index is logged rather than reported as each store remains effective without it (expired records are simply kept
+// around). Registrations rely on their unique index to refuse duplicates, so failing to create it (or to reach the database at all) is reported.
+//
+// Returns:
+//   - `error`: issue creating the indexes registrations depend on (nil if no issue occurred)
+func (srv *tournabyteAPIService) prepareIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	sessCtx, err := srv.db.SetUpSession(ctx)
+	if err != nil {
+		log.Printf("Could not prepare database indexes: %s\n", err.Error())
+		return err
+	}
+	defer srv.db.TearDownSession(sessCtx)
+
+	if err := srv.revocations.EnsureIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the token revocation store: %s\n", err.Error())
+	}
+	if err := ensurePasswordResetIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the password reset store: %s\n", err.Error())
+	}
+	if err := ensureEmailVerificationIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the email verification store: %s\n", err.Error())
 	}
+	if err := srv.throttle.EnsureIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the login attempt store: %s\n", err.Error())
+	}
+	if err := ensureMFAChallengeIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the login challenge store: %s\n", err.Error())
+	}
+	if err := ensureAPIKeyIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the API key store: %s\n", err.Error())
+	}
+	if err := ensureOIDCIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the external login store: %s\n", err.Error())
+	}
+	if err := ensureDataExportIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the data export store: %s\n", err.Error())
+	}
+	if err := ensureRegistrationIndexes(sessCtx); err != nil {
+		log.Printf("Could not prepare the participant store: %s\n", err.Error())
+		return err
+	}
+	return nil
 }
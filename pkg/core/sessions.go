@@ -0,0 +1,307 @@
+package core
+
+/*
+ * File: pkg/core/sessions.go
+ *
+ * Purpose: listing and revocation of the sessions of the current user
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Workspace keys associated with session management workspace tasks
+const (
+	sessionFamilyRequest         = "sessionFamilyRequest"
+	activeSessionListKey         = "activeSessionList"
+	sessionRevocationResponseKey = "sessionRevocationResponse"
+)
+
+// Function `(*tournabyteAPIService).initSessionManagementWorkspace` initializes the handler workspace for a session management request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for managing the sessions of the current user
+func (srv *tournabyteAPIService) initSessionManagementWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveURIValues|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings")
+	return &space
+}
+
+// Function `listSessionsPipeline` initializes a handling pipeline for listing the sessions of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func listSessionsPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchActiveSessionsOfUser, out2)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `revokeAllSessionsPipeline` initializes a handling pipeline for logging the current user out everywhere
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func revokeAllSessionsPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeSessionsOfUser, out2)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(activeUserTokenScope), out3)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `revokeSessionPipeline` initializes a handling pipeline for revoking a single session of the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func revokeSessionPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindSessionFamilyFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, removeSessionsOfUser, out3)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, revokeTokensOf(activeUserTokenScope), out4)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindSessionFamilyFromURI` binds the request URI to the session family format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindSessionFamilyFromURI(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.SessionFamilyID
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request URI to variable of type %T...", lookup)
+	if err := bindings.BindURI(&lookup); err != nil {
+		log.Printf("[HANDLER]: error binding request URI (%s)", err.Error())
+		return err
+	}
+
+	space.Set(sessionFamilyRequest, lookup)
+	log.Printf("[HANDLER]: saved request URI as variable of type %T within workspace under key %q", lookup, sessionFamilyRequest)
+	return nil
+}
+
+// Function `fetchActiveSessionsOfUser` retrieves the unexpired, unrotated session records authorizing the user presented in the access token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func fetchActiveSessionsOfUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var sess *mongo.Session
+	var cur *mongo.Cursor
+	var records []models.UserSession
+	var cfg *options.FindOptionsBuilder
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.FindSortKey(bson.E{Key: "created_at", Value: -1})); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{
+		{Key: "authorizes", Value: userid},
+		{Key: "rotated", Value: false},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}
+	cur, err = sess.Client().
+		Database(models.UserSessionQueryContext.Database).
+		Collection(models.UserSessionQueryContext.Collection).
+		Find(ctx, filter, cfg)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	if err = cur.All(ctx, &records); err != nil {
+		log.Printf("[HANDLER]: error during database lookup operation (%s)", err.Error())
+		return err
+	}
+
+	sessions := make([]models.ActiveSession, 0, len(records))
+	for _, record := range records {
+		sessions = append(sessions, activeSessionOf(record))
+	}
+
+	log.Printf("[HANDLER]: found %d active sessions for user %q", len(sessions), whoami)
+	space.Set(activeSessionListKey, sessions)
+	return nil
+}
+
+// Function `removeSessionsOfUser` removes the session records authorizing the user presented in the access token
+//
+// Only the session family referenced by the request URI is removed when present within the workspace; otherwise every session of the user is removed.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func removeSessionsOfUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var whoami string
+	var userid bson.ObjectID
+	var lookup models.SessionFamilyID
+	var sess *mongo.Session
+	var res *mongo.DeleteResult
+	var err error
+
+	log.Printf("[HANDLER]: loading user ID within access token under %q into variable of type %T...", activeUserID, whoami)
+	if err = space.Get(activeUserID, &whoami); err != nil {
+		log.Printf("[HANDLER]: error loading user ID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: converting user ID hex to an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(whoami); err != nil {
+		log.Printf("[HANDLER]: error converting user ID hex to ObjectID (%s)", err.Error())
+		return err
+	}
+
+	filter := bson.D{{Key: "authorizes", Value: userid}}
+
+	log.Printf("[HANDLER]: loading session family request from workspace under %q key (if any)...", sessionFamilyRequest)
+	if err = space.Get(sessionFamilyRequest, &lookup); err == nil {
+		family, err := bson.ObjectIDFromHex(lookup.ID)
+		if err != nil {
+			log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+			return err
+		}
+		filter = append(filter, bson.E{Key: "family", Value: family})
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation (authorizes=%q)", whoami)
+	res, err = sess.Client().
+		Database(models.UserSessionQueryContext.Database).
+		Collection(models.UserSessionQueryContext.Collection).
+		DeleteMany(ctx, filter)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database deletion (%s)", err.Error())
+		return err
+	}
+
+	if lookup.ID != "" && res.DeletedCount == 0 {
+		log.Printf("[HANDLER]: no session (family=%q) found for user %q", lookup.ID, whoami)
+		return mongo.ErrNoDocuments
+	}
+
+	log.Printf("[HANDLER]: removed %d session records of user %q", res.DeletedCount, whoami)
+	space.Set(sessionRevocationResponseKey, models.SessionRevocationResponse{Revoked: res.DeletedCount})
+	return nil
+}
+
+// Function `activeSessionOf` projects a session record onto the details shown to the user it authorizes
+//
+// Parameters:
+//   - record: the session record to project
+//
+// Returns:
+//   - `models.ActiveSession`: the session as shown to its user
+func activeSessionOf(record models.UserSession) models.ActiveSession {
+	active := models.ActiveSession{
+		ID:        record.Family.Hex(),
+		CreatedAt: record.CreatedAt,
+		ExpiresAt: record.NotValidAfter,
+		Client:    record.Client,
+	}
+	if record.NotValidBefore.After(record.CreatedAt) {
+		active.LastRotatedAt = record.NotValidBefore
+	}
+	return active
+}
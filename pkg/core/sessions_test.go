@@ -0,0 +1,110 @@
+package core
+
+/*
+ * File: pkg/core/sessions_test.go
+ *
+ * Purpose: unit tests for the session management pipelines
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+var (
+	deleteNoneOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "n", Value: 0}, // matched count
+	}
+)
+
+func TestSessionManagementPipeline(t *testing.T) {
+	t.Run("ListSessionsOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := listSessionsPipeline(setupWorkingAdminContext(t, findSessionOk))
+		var result []models.ActiveSession
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(activeSessionListKey, &result))
+
+		require.Len(t, result, 1)
+		assert.Equal(t, findSessDoc[0].(bson.M)["family"].(bson.ObjectID).Hex(), result[0].ID)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("RevokeAllSessionsOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := revokeAllSessionsPipeline(setupWorkingAdminContext(t, deleteManyOk))
+		var result models.SessionRevocationResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(sessionRevocationResponseKey, &result))
+
+		assert.Equal(t, int64(3), result.Revoked)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("RevokeSessionOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := revokeSessionPipeline(setupWorkingAdminContext(t, deleteOneOk))
+		var result models.SessionRevocationResponse
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, models.SessionFamilyID{ID: bson.NewObjectID().Hex()}, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(sessionRevocationResponseKey, &result))
+
+		assert.Equal(t, int64(1), result.Revoked)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("RevokeUnknownSessionFails", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := revokeSessionPipeline(setupWorkingAdminContext(t, deleteNoneOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, models.SessionFamilyID{ID: bson.NewObjectID().Hex()}, nil)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), mongo.ErrNoDocuments)
+	})
+}
@@ -0,0 +1,569 @@
+package core
+
+/*
+ * File: pkg/core/staff.go
+ *
+ * Purpose: event staff management logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Workspace keys associated with event staff management workspace tasks
+const (
+	staffInvitationRequest = "inviteStaffRequest"
+	staffLookupRequest     = "lookupStaffRequest"
+	staffRoleChangeRequest = "changeStaffRoleRequest"
+	staffMemberKey         = "staffMember"
+	staffListKey           = "staffList"
+)
+
+// Errors specific to event staff management workflow tasks
+var (
+	ErrAlreadyStaff  = errors.New("user is already on the event staff")
+	ErrNotStaff      = errors.New("user is not on the event staff")
+	ErrHostRoleFixed = errors.New("event host role cannot be changed")
+)
+
+// Function `listStaffPipeline` initializes a handling pipeline for retrieving an event's staff
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func listStaffPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permViewEvent), out4)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, populateEventStaffList, out5)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `addStaffMemberPipeline` initializes a handling pipeline for adding a user to an event's staff
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func addStaffMemberPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEventLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageStaff), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindStaffInvitationFromBody, out5)
+	out7 := handlerutil.Stage(pipelineCtx, pipelineCancel, resolveStaffInvitationUser, out6)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, addEventStaffMember, out7)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `changeStaffRolePipeline` initializes a handling pipeline for changing the role of an event staff member
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func changeStaffRolePipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindStaffMemberLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageStaff), out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindStaffRoleChangeFromBody, out5)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, updateEventStaffMemberRole, out6)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `removeStaffMemberPipeline` initializes a handling pipeline for removing a user from an event's staff
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func removeStaffMemberPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindStaffMemberLookupRequestFromURI, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchEventRecordFromDatabaseByID, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireEventPermission(permManageStaff), out4)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, removeEventStaffMember, out5)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindStaffMemberLookupRequestFromURI` binds the request URI to the staff member lookup request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindStaffMemberLookupRequestFromURI(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var lookup models.StaffMemberID
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request URI to variable of type %T...", lookup)
+	if err := bindings.BindURI(&lookup); err != nil {
+		log.Printf("[HANDLER]: error binding request URI (%s)", err.Error())
+		return err
+	}
+
+	space.Set(staffLookupRequest, lookup)
+	space.Set(eventLookupRequest, models.EventID{ID: lookup.EID})
+	log.Printf("[HANDLER]: saved request URI as variable of type %T within workspace under key %q", lookup, staffLookupRequest)
+	return nil
+}
+
+// Function `bindStaffInvitationFromBody` binds the request body to the staff invitation request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindStaffInvitationFromBody(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.InviteStaffRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T...", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(staffInvitationRequest, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, staffInvitationRequest)
+	return nil
+}
+
+// Function `bindStaffRoleChangeFromBody` binds the request body to the staff role change request format (and validates it)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func bindStaffRoleChangeFromBody(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.ChangeStaffRoleRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T...", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(staffRoleChangeRequest, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, staffRoleChangeRequest)
+	return nil
+}
+
+// Function `resolveStaffInvitationUser` verifies the user referenced by the staff invitation belongs to an active account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrUnknownUser` if no active account has the referenced ID (or another error that occurred during this processing step)
+func resolveStaffInvitationUser(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.InviteStaffRequest
+	var userid bson.ObjectID
+	var sess *mongo.Session
+	var err error
+
+	log.Printf("[HANDLER]: loading staff invitation from workspace under %q key into variable of type %T...", staffInvitationRequest, req)
+	if err = space.Get(staffInvitationRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading staff invitation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in staff invitation as an ObjectID...")
+	if userid, err = bson.ObjectIDFromHex(req.User); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database lookup operation")
+	filter := bson.D{{Key: "_id", Value: userid}, {Key: "metadata.active", Value: bson.D{{Key: "$ne", Value: false}}}}
+	err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		FindOne(ctx, filter, options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})).
+		Err()
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no active account (_id=%q) to add to the event staff", req.User)
+		return ErrUnknownUser
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database lookup (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: staff invitation references account (_id=%q)", req.User)
+	return nil
+}
+
+// Function `populateEventStaffList` populates the staff list of the event record within the workspace (the host is listed first as the owner)
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func populateEventStaffList(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err := space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	staff := append([]models.EventStaffMember{{User: event.Host, Role: models.RoleOwner}}, event.Staff...)
+	log.Printf("[HANDLER]: saved %d staff members to workspace under the %q key", len(staff), staffListKey)
+	space.Set(staffListKey, staff)
+	return nil
+}
+
+// Function `addEventStaffMember` adds the user within the staff invitation request to the staff of the event record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func addEventStaffMember(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var req models.InviteStaffRequest
+	var member models.EventStaffMember
+	var cfg *options.UpdateOneOptionsBuilder
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading staff invitation from workspace under %q key into variable of type %T...", staffInvitationRequest, req)
+	if err = space.Get(staffInvitationRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading staff invitation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in staff invitation as an ObjectID...")
+	if member.User, err = bson.ObjectIDFromHex(req.User); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+	member.Role = req.Role
+
+	if eventRoleOf(event, member.User) != "" {
+		log.Printf("[HANDLER]: user (_id=%q) already has a role on the event", req.User)
+		return ErrAlreadyStaff
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: event.ID}, {Key: "staff.user", Value: bson.D{{Key: "$ne", Value: member.User}}}},
+			bson.D{{Key: "$push", Value: bson.D{{Key: "staff", Value: member}}}},
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		log.Printf("[HANDLER]: user (_id=%q) was added to the event staff concurrently", req.User)
+		return ErrAlreadyStaff
+	}
+
+	if res.ModifiedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return errors.New("update not properly applied")
+	}
+
+	log.Printf("[HANDLER]: user (_id=%q) added to event (_id=%q) staff as %q", req.User, event.ID.Hex(), member.Role)
+	space.Set(staffMemberKey, member)
+	return nil
+}
+
+// Function `updateEventStaffMemberRole` changes the role of the staff member within the staff lookup request on the event record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func updateEventStaffMemberRole(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var lookup models.StaffMemberID
+	var req models.ChangeStaffRoleRequest
+	var member models.EventStaffMember
+	var cfg *options.UpdateOneOptionsBuilder
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading staff lookup request from workspace under %q key into variable of type %T...", staffLookupRequest, lookup)
+	if err = space.Get(staffLookupRequest, &lookup); err != nil {
+		log.Printf("[HANDLER]: error loading staff lookup request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading role change from workspace under %q key into variable of type %T...", staffRoleChangeRequest, req)
+	if err = space.Get(staffRoleChangeRequest, &req); err != nil {
+		log.Printf("[HANDLER]: error loading role change (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in staff lookup request as an ObjectID...")
+	if member.User, err = bson.ObjectIDFromHex(lookup.UID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+	member.Role = req.Role
+
+	if member.User == event.Host {
+		log.Printf("[HANDLER]: role of the event host cannot be changed")
+		return ErrHostRoleFixed
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: event.ID}, {Key: "staff.user", Value: member.User}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "staff.$.role", Value: member.Role}}}},
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return ErrNotStaff
+	}
+
+	log.Printf("[HANDLER]: user (_id=%q) role on event (_id=%q) changed to %q", lookup.UID, event.ID.Hex(), member.Role)
+	space.Set(staffMemberKey, member)
+	return nil
+}
+
+// Function `removeEventStaffMember` removes the staff member within the staff lookup request from the event record within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func removeEventStaffMember(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var event models.EventRecord
+	var lookup models.StaffMemberID
+	var member models.EventStaffMember
+	var cfg *options.UpdateOneOptionsBuilder
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var err error
+
+	log.Printf("[HANDLER]: loading event record from workspace under %q key into variable of type %T...", eventRecordKey, event)
+	if err = space.Get(eventRecordKey, &event); err != nil {
+		log.Printf("[HANDLER]: error loading record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading staff lookup request from workspace under %q key into variable of type %T...", staffLookupRequest, lookup)
+	if err = space.Get(staffLookupRequest, &lookup); err != nil {
+		log.Printf("[HANDLER]: error loading staff lookup request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: interpreting ID presented in staff lookup request as an ObjectID...")
+	if member.User, err = bson.ObjectIDFromHex(lookup.UID); err != nil {
+		log.Printf("[HANDLER]: could not interpret provided ID as an ObjectID (%s)", err.Error())
+		return err
+	}
+
+	if member.Role = eventRoleOf(event, member.User); member.Role == "" || member.Role == models.RoleOwner {
+		log.Printf("[HANDLER]: user (_id=%q) has no removable role on the event (role %q)", lookup.UID, member.Role)
+		return ErrNotStaff
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateUpdatedDocument(true), dbx.DoInsertOnNoMatchFound(false)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.EventQueryContext.Database).
+		Collection(models.EventQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: event.ID}},
+			bson.D{{Key: "$pull", Value: bson.D{{Key: "staff", Value: bson.D{{Key: "user", Value: member.User}}}}}},
+			cfg,
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.ModifiedCount != 1 {
+		log.Printf("[HANDLER]: incorrect number of documents updated (found %d; update %d)", res.MatchedCount, res.ModifiedCount)
+		return errors.New("update not properly applied")
+	}
+
+	log.Printf("[HANDLER]: user (_id=%q) removed from event (_id=%q) staff", lookup.UID, event.ID.Hex())
+	space.Set(staffMemberKey, member)
+	return nil
+}
+
+// Function `isStaffError` determines if the given error is a staff change that conflicts with the current event staff
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isStaffError(e error) (error, bool) {
+	if errors.Is(e, ErrAlreadyStaff) || errors.Is(e, ErrNotStaff) || errors.Is(e, ErrHostRoleFixed) {
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("staff", e.Error()),
+		), true
+	}
+	return nil, false
+}
@@ -0,0 +1,138 @@
+package core
+
+/*
+ * File: pkg/core/staff_test.go
+ *
+ * Purpose: unit tests for the event staff management pipelines
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+)
+
+func setupWorkingStaffUpdateContext(t *testing.T, userLookup bson.D) context.Context {
+	t.Helper()
+
+	m := drivertest.NewMockDeployment(
+		pingResponse,
+		findEventOk,
+		userLookup,
+		updateOneOk,
+	)
+	mockDb, err := dbx.NewMongoConnection(
+		dbx.ConnectionDeployment(m),
+	)
+	require.NoError(t, err)
+
+	ctx, err := mockDb.SetUpSession(context.Background())
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func TestEventStaffPipeline(t *testing.T) {
+	t.Run("ListStaffIncludesOwner", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := listStaffPipeline(setupWorkingEventLookupContext(t))
+		var result []models.EventStaffMember
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, nil)
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(staffListKey, &result))
+
+		require.Len(t, result, 1)
+		assert.Equal(t, findEventDoc[0].(bson.M)["host"].(bson.ObjectID), result[0].User)
+		assert.Equal(t, models.RoleOwner, result[0].Role)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("AddStaffMemberOk", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := addStaffMemberPipeline(setupWorkingStaffUpdateContext(t, findUserOk))
+		var result models.EventStaffMember
+		defer close(pIn)
+		defer pCancel(nil)
+
+		user := bson.NewObjectID()
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.InviteStaffRequest{User: user.Hex(), Role: models.RoleScorekeeper})
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(staffMemberKey, &result))
+
+		assert.Equal(t, user, result.User)
+		assert.Equal(t, models.RoleScorekeeper, result.Role)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("AddHostRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := addStaffMemberPipeline(setupWorkingStaffUpdateContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		host := findEventDoc[0].(bson.M)["host"].(bson.ObjectID)
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.InviteStaffRequest{User: host.Hex(), Role: models.RoleViewer})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrAlreadyStaff)
+		_, classified := isStaffError(context.Cause(pCtx))
+		assert.True(t, classified)
+	})
+
+	t.Run("AddUnknownUserRejected", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := addStaffMemberPipeline(setupWorkingStaffUpdateContext(t, findNoneOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingBracketBuilderWorkspace(t, models.InviteStaffRequest{User: bson.NewObjectID().Hex(), Role: models.RoleViewer})
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrUnknownUser)
+	})
+
+	t.Run("NonStaffCannotAddMembers", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := addStaffMemberPipeline(setupWorkingStaffUpdateContext(t, findUserOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupWorkingEventLookupWorkspace(t)
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		_, rejected := isPermissionError(context.Cause(pCtx))
+		assert.True(t, rejected)
+	})
+}
@@ -0,0 +1,227 @@
+package core
+
+/*
+ * File: pkg/core/standings.go
+ *
+ * Purpose: standings calculation from declared match results
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Function `isDecided` determines if a match was played between two participants and has a result
+//
+// Parameters:
+//   - m: the match to check
+//
+// Returns:
+//   - `bool`: whether the match counts towards the standings
+func isDecided(m models.EventMatch) bool {
+	if m.HomeRef != models.ParticipantFieldReferencesPlayer || m.AwayRef != models.ParticipantFieldReferencesPlayer {
+		return false
+	}
+	return m.Draw || m.Winner != bson.NilObjectID
+}
+
+// Function `isAwardedBye` determines if a match awards a swiss round bye (a win without an opponent)
+//
+// Parameters:
+//   - m: the match to check
+//
+// Returns:
+//   - `bool`: whether the match counts as a win for its only participant
+func isAwardedBye(m models.EventMatch) bool {
+	return m.Bracket == models.BracketSwiss && m.Winner != bson.NilObjectID &&
+		(m.HomeRef == models.ParticipantFieldReferencesBye || m.AwayRef == models.ParticipantFieldReferencesBye)
+}
+
+// Function `pointsEarned` calculates the points a participant earned from a decided match
+//
+// Parameters:
+//   - m: the decided match
+//   - who: the participant to calculate the points for
+//
+// Returns:
+//   - `int`: the points earned
+func pointsEarned(m models.EventMatch, who bson.ObjectID) int {
+	switch {
+	case m.Draw:
+		return models.PointsForDraw
+	case m.Winner == who:
+		return models.PointsForWin
+	default:
+		return models.PointsForLoss
+	}
+}
+
+// Function `computeStandings` tallies the results of the decided matches for every participant and ranks them within their group
+//
+// Participants are ordered by points, then by the points earned against the participants they are tied with, then by Buchholz score, then by opponent match
+// win percentage, then by wins and finally by display name. Swiss byes count as a won match but do not count as an opponent for the tiebreakers.
+//
+// Parameters:
+//   - participants: the participants of the event
+//   - matches: the matches of the event
+//
+// Returns:
+//   - `[]models.EventStanding`: the standings, ordered by group then rank
+func computeStandings(participants []models.EventParticipant, matches []models.EventMatch) []models.EventStanding {
+	index := make(map[bson.ObjectID]int)
+	standings := make([]models.EventStanding, 0, len(participants))
+
+	for _, p := range participants {
+		index[p.ID] = len(standings)
+		standings = append(standings, models.EventStanding{
+			Participant: p.ID,
+			DisplayName: p.DisplayName,
+		})
+	}
+
+	for _, m := range matches {
+		if m.Group != 0 {
+			for _, who := range []bson.ObjectID{m.HomeParticipant, m.AwayParticipant} {
+				if i, found := index[who]; found {
+					standings[i].Group = m.Group
+				}
+			}
+		}
+
+		if isAwardedBye(m) {
+			if i, found := index[m.Winner]; found {
+				standings[i].Played++
+				standings[i].Wins++
+				standings[i].Points += models.PointsForWin
+			}
+			continue
+		}
+
+		if !isDecided(m) {
+			continue
+		}
+
+		for _, who := range []bson.ObjectID{m.HomeParticipant, m.AwayParticipant} {
+			i, found := index[who]
+			if !found {
+				continue
+			}
+
+			standings[i].Played++
+			standings[i].Points += pointsEarned(m, who)
+			switch {
+			case m.Draw:
+				standings[i].Draws++
+			case m.Winner == who:
+				standings[i].Wins++
+			default:
+				standings[i].Losses++
+			}
+		}
+	}
+
+	winPercentage := func(s models.EventStanding) float64 {
+		if s.Played == 0 {
+			return 1.0 / 3.0
+		}
+		return max(float64(s.Points)/float64(models.PointsForWin*s.Played), 1.0/3.0)
+	}
+	opponents := make([]int, len(standings))
+	omwp := make([]float64, len(standings))
+
+	for _, m := range matches {
+		if !isDecided(m) {
+			continue
+		}
+
+		home, homeFound := index[m.HomeParticipant]
+		away, awayFound := index[m.AwayParticipant]
+		if !homeFound || !awayFound {
+			continue
+		}
+
+		standings[home].Buchholz += standings[away].Points
+		standings[away].Buchholz += standings[home].Points
+		omwp[home] += winPercentage(standings[away])
+		omwp[away] += winPercentage(standings[home])
+		opponents[home]++
+		opponents[away]++
+
+		if standings[home].Points == standings[away].Points {
+			standings[home].HeadToHead += pointsEarned(m, m.HomeParticipant)
+			standings[away].HeadToHead += pointsEarned(m, m.AwayParticipant)
+		}
+	}
+
+	for i := range standings {
+		if opponents[i] > 0 {
+			standings[i].OMWP = omwp[i] / float64(opponents[i])
+		}
+	}
+
+	slices.SortStableFunc(standings, func(a, b models.EventStanding) int {
+		return cmp.Or(
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(b.Points, a.Points),
+			cmp.Compare(b.HeadToHead, a.HeadToHead),
+			cmp.Compare(b.Buchholz, a.Buchholz),
+			cmp.Compare(b.OMWP, a.OMWP),
+			cmp.Compare(b.Wins, a.Wins),
+			cmp.Compare(a.DisplayName, b.DisplayName),
+		)
+	})
+
+	for i := range standings {
+		if i > 0 && standings[i].Group == standings[i-1].Group {
+			standings[i].Rank = standings[i-1].Rank + 1
+		} else {
+			standings[i].Rank = 1
+		}
+	}
+
+	return standings
+}
+
+// Function `seriesScore` counts the games won by each side of a best-of series
+//
+// Parameters:
+//   - bestOf: the maximum number of games in the series (zero is treated as a single game)
+//   - games: the game-by-game scores recorded for the series
+//
+// Returns:
+//   - `int`: the number of games won by the home participant
+//   - `int`: the number of games won by the away participant
+//   - `error`: `ErrEvenSeriesLength`, `ErrSeriesTooLong` or `ErrGamesAfterSeriesDecided` if the series length or the recorded games are invalid
+func seriesScore(bestOf int, games []models.MatchGame) (int, int, error) {
+	var home, away int
+
+	bestOf = max(bestOf, 1)
+	if bestOf%2 == 0 {
+		return 0, 0, ErrEvenSeriesLength
+	}
+	if len(games) > bestOf {
+		return 0, 0, ErrSeriesTooLong
+	}
+
+	for _, g := range games {
+		if max(home, away) > bestOf/2 {
+			return 0, 0, ErrGamesAfterSeriesDecided
+		}
+		switch {
+		case g.Home > g.Away:
+			home++
+		case g.Away > g.Home:
+			away++
+		}
+	}
+
+	return home, away, nil
+}
@@ -0,0 +1,137 @@
+package core
+
+/*
+ * File: pkg/core/standings_test.go
+ *
+ * Purpose: unit tests for the standings calculation logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestComputeStandings(t *testing.T) {
+	event := bson.NewObjectID()
+	participants := generateParticipants(t, event, 4)
+	a, b, c, d := participants[0].ID, participants[1].ID, participants[2].ID, participants[3].ID
+
+	played := func(home, away, winner bson.ObjectID) models.EventMatch {
+		m := newMatch(event, models.BracketRoundRobin, playerSlot(home), playerSlot(away))
+		m.Winner = winner
+		m.Draw = winner == bson.NilObjectID
+		return m
+	}
+
+	t.Run("TalliesResults", func(t *testing.T) {
+		matches := []models.EventMatch{
+			played(a, b, a),
+			played(c, d, bson.NilObjectID),
+			played(a, c, a),
+			played(b, d, d),
+			newMatch(event, models.BracketRoundRobin, playerSlot(a), playerSlot(d)),
+		}
+
+		standings := computeStandings(participants, matches)
+		require.Len(t, standings, 4)
+
+		assert.Equal(t, a, standings[0].Participant)
+		assert.Equal(t, 2, standings[0].Wins)
+		assert.Equal(t, 2, standings[0].Played)
+		assert.Equal(t, 2*models.PointsForWin, standings[0].Points)
+
+		assert.Equal(t, d, standings[1].Participant)
+		assert.Equal(t, 1, standings[1].Wins)
+		assert.Equal(t, 1, standings[1].Draws)
+
+		assert.Equal(t, c, standings[2].Participant)
+		assert.Equal(t, b, standings[3].Participant)
+		assert.Equal(t, 2, standings[3].Losses)
+
+		for i, s := range standings {
+			assert.Equal(t, i+1, s.Rank)
+		}
+	})
+
+	t.Run("HeadToHeadBreaksTies", func(t *testing.T) {
+		matches := []models.EventMatch{
+			played(a, b, b),
+			played(a, c, a),
+			played(b, d, d),
+		}
+
+		standings := computeStandings(participants, matches)
+
+		assert.Equal(t, b, standings[0].Participant)
+		assert.Equal(t, models.PointsForWin, standings[0].HeadToHead)
+		assert.Equal(t, d, standings[1].Participant)
+		assert.Equal(t, a, standings[2].Participant)
+		assert.Zero(t, standings[2].HeadToHead)
+	})
+
+	t.Run("BuchholzBreaksTies", func(t *testing.T) {
+		matches := []models.EventMatch{
+			played(a, b, a),
+			played(c, d, c),
+			played(b, d, b),
+		}
+
+		standings := computeStandings(participants, matches)
+
+		assert.Equal(t, []bson.ObjectID{a, b, c, d}, []bson.ObjectID{standings[0].Participant, standings[1].Participant, standings[2].Participant, standings[3].Participant})
+		assert.Equal(t, models.PointsForWin, standings[1].Buchholz)
+		assert.Zero(t, standings[2].Buchholz)
+		assert.InDelta(t, 2.0/3.0, standings[1].OMWP, 1e-9)
+		assert.InDelta(t, 1.0/3.0, standings[2].OMWP, 1e-9)
+	})
+
+	t.Run("RanksWithinGroups", func(t *testing.T) {
+		first := played(a, b, b)
+		first.Group = 1
+		second := played(c, d, c)
+		second.Group = 2
+
+		standings := computeStandings(participants, []models.EventMatch{first, second})
+
+		assert.Equal(t, []int{1, 1, 2, 2}, []int{standings[0].Group, standings[1].Group, standings[2].Group, standings[3].Group})
+		assert.Equal(t, []int{1, 2, 1, 2}, []int{standings[0].Rank, standings[1].Rank, standings[2].Rank, standings[3].Rank})
+		assert.Equal(t, b, standings[0].Participant)
+		assert.Equal(t, c, standings[2].Participant)
+	})
+}
+
+func TestSeriesScore(t *testing.T) {
+	t.Run("CountsGameWins", func(t *testing.T) {
+		home, away, err := seriesScore(5, []models.MatchGame{{Home: 2, Away: 1}, {Home: 0, Away: 3}, {Home: 1, Away: 1}, {Home: 4, Away: 0}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, home)
+		assert.Equal(t, 1, away)
+	})
+
+	t.Run("SingleGameByDefault", func(t *testing.T) {
+		_, _, err := seriesScore(0, []models.MatchGame{{Home: 1}, {Away: 1}})
+		assert.ErrorIs(t, err, ErrSeriesTooLong)
+	})
+
+	t.Run("EvenSeriesRejected", func(t *testing.T) {
+		_, _, err := seriesScore(4, nil)
+		assert.ErrorIs(t, err, ErrEvenSeriesLength)
+	})
+
+	t.Run("GamesAfterClinchRejected", func(t *testing.T) {
+		_, _, err := seriesScore(5, []models.MatchGame{{Home: 1}, {Home: 1}, {Home: 1}, {Away: 1}})
+		assert.ErrorIs(t, err, ErrGamesAfterSeriesDecided)
+
+		_, classified := isMatchResultError(err)
+		assert.True(t, classified)
+	})
+}
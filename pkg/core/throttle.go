@@ -0,0 +1,488 @@
+package core
+
+/*
+ * File: pkg/core/throttle.go
+ *
+ * Purpose: failed login tracking and the processing steps that lock out repeated password guessing
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Login throttling behaviour used when not configured
+const (
+	defaultAccountLoginFailures = 5
+	defaultClientLoginFailures  = 20
+	defaultLoginLockout         = 30 * time.Second
+	defaultMaxLoginLockout      = 15 * time.Minute
+	defaultLoginFailureWindow   = 15 * time.Minute
+)
+
+// Workspace key the login throttle is stored under
+const loginThrottleKey = "loginThrottle"
+
+// Error returned when login attempts are refused because of repeated failures
+var ErrTooManyLoginAttempts = errors.New("too many failed login attempts")
+
+// Type `loginLockoutError` represents a refused login attempt along with how long the lockout lasts
+//
+// Members:
+//   - retryAfter: the remaining duration of the lockout
+type loginLockoutError struct {
+	retryAfter time.Duration
+}
+
+// Function `loginLockoutError.Error` implements the error interface for the loginLockoutError type
+func (e loginLockoutError) Error() string {
+	return ErrTooManyLoginAttempts.Error()
+}
+
+// Function `loginLockoutError.Unwrap` allows `errors.Is(e, ErrTooManyLoginAttempts)` to match lockouts
+func (e loginLockoutError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
+
+// Type `loginScope` represents a subject failed logins are counted against
+//
+// Members:
+//   - id: the identifier of the scope within the login attempt collection
+//   - threshold: the consecutive failures tolerated before the scope is locked
+type loginScope struct {
+	id        string
+	threshold int
+}
+
+// Type `mongoLoginThrottle` tracks failed logins per account and per client address within a MongoDB collection
+//
+// Records are written through an independent session so failures remain counted when the request transaction of the failed login rolls back.
+//
+// Members:
+//   - accountFailures: the consecutive failures against one account before it is locked
+//   - clientFailures: the consecutive failures from one client address before it is locked
+//   - lockout: the duration of the first lockout
+//   - maxLockout: the longest duration a single lockout may last
+//   - window: the duration without failures after which recorded failures are forgotten
+type mongoLoginThrottle struct {
+	accountFailures int
+	clientFailures  int
+	lockout         time.Duration
+	maxLockout      time.Duration
+	window          time.Duration
+}
+
+// Function `newMongoLoginThrottle` creates a login throttle with the given limits (zero values fall back to the defaults)
+//
+// Parameters:
+//   - accountFailures: the consecutive failures against one account before it is locked
+//   - clientFailures: the consecutive failures from one client address before it is locked
+//   - lockout: the duration of the first lockout (doubled with each further failure)
+//   - maxLockout: the longest duration a single lockout may last
+//   - window: the duration without failures after which recorded failures are forgotten
+//
+// Returns:
+//   - `*mongoLoginThrottle`: the login throttle
+func newMongoLoginThrottle(accountFailures int, clientFailures int, lockout time.Duration, maxLockout time.Duration, window time.Duration) *mongoLoginThrottle {
+	return &mongoLoginThrottle{
+		accountFailures: cmp.Or(accountFailures, defaultAccountLoginFailures),
+		clientFailures:  cmp.Or(clientFailures, defaultClientLoginFailures),
+		lockout:         cmp.Or(lockout, defaultLoginLockout),
+		maxLockout:      cmp.Or(maxLockout, defaultMaxLoginLockout),
+		window:          cmp.Or(window, defaultLoginFailureWindow),
+	}
+}
+
+// Function `(*mongoLoginThrottle).EnsureIndexes` creates the TTL index removing login attempts once they are forgotten
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the index (nil if no issue occurred)
+func (throttle *mongoLoginThrottle) EnsureIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.LoginAttemptQueryContext.Database).
+		Collection(models.LoginAttemptQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		})
+	return err
+}
+
+// Function `(*mongoLoginThrottle).Check` determines how long login attempts for the given account from the given client are refused
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - email: the login email of the attempt
+//   - client: the address of the client making the attempt
+//
+// Returns:
+//   - `time.Duration`: the remaining duration of the longest applicable lockout (zero if attempts are allowed)
+//   - `error`: issue reading the database (nil if no issue occurred)
+func (throttle *mongoLoginThrottle) Check(ctx context.Context, email string, client string) (time.Duration, error) {
+	var records []models.LoginAttempts
+	var wait time.Duration
+	var now = time.Now().UTC()
+
+	coll, ctx, end, err := throttle.collection(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer end()
+
+	cur, err := coll.Find(ctx, bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: scopeIDs(throttle.scopes(email, client))}}},
+		{Key: "locked_until", Value: bson.D{{Key: "$gt", Value: now}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err = cur.All(ctx, &records); err != nil {
+		return 0, err
+	}
+
+	for _, record := range records {
+		wait = max(wait, record.LockedUntil.Sub(now))
+	}
+	return wait, nil
+}
+
+// Function `(*mongoLoginThrottle).RecordFailure` counts a failed login for the given account and client, locking either once its threshold is reached
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - email: the login email of the attempt
+//   - client: the address of the client making the attempt
+//
+// Returns:
+//   - `time.Duration`: the duration of the longest lockout caused by this failure (zero if no lockout was caused)
+//   - `error`: issue writing the database (nil if no issue occurred)
+func (throttle *mongoLoginThrottle) RecordFailure(ctx context.Context, email string, client string) (time.Duration, error) {
+	var wait time.Duration
+	var now = time.Now().UTC()
+
+	coll, ctx, end, err := throttle.collection(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer end()
+
+	cfg := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	for _, scope := range throttle.scopes(email, client) {
+		var record models.LoginAttempts
+
+		err = coll.FindOneAndUpdate(
+			ctx,
+			bson.D{{Key: "_id", Value: scope.id}},
+			bson.D{
+				{Key: "$inc", Value: bson.D{{Key: "failures", Value: 1}}},
+				{Key: "$max", Value: bson.D{{Key: "expires_at", Value: now.Add(throttle.window)}}},
+			},
+			cfg,
+		).Decode(&record)
+		if err != nil {
+			return 0, err
+		}
+
+		if record.Failures < scope.threshold {
+			continue
+		}
+
+		lockout := throttle.lockoutAfter(record.Failures - scope.threshold)
+		if _, err = coll.UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: scope.id}},
+			bson.D{{Key: "$max", Value: bson.D{
+				{Key: "locked_until", Value: now.Add(lockout)},
+				{Key: "expires_at", Value: now.Add(lockout + throttle.window)},
+			}}},
+		); err != nil {
+			return 0, err
+		}
+		wait = max(wait, lockout)
+	}
+	return wait, nil
+}
+
+// Function `(*mongoLoginThrottle).Reset` forgets the failed logins recorded for the given account and client
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//   - email: the login email of the successful attempt
+//   - client: the address of the client making the successful attempt
+//
+// Returns:
+//   - `error`: issue writing the database (nil if no issue occurred)
+func (throttle *mongoLoginThrottle) Reset(ctx context.Context, email string, client string) error {
+	coll, ctx, end, err := throttle.collection(ctx)
+	if err != nil {
+		return err
+	}
+	defer end()
+
+	_, err = coll.DeleteMany(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: scopeIDs(throttle.scopes(email, client))}}}})
+	return err
+}
+
+// Function `(*mongoLoginThrottle).lockoutAfter` determines the lockout caused by a failure beyond the threshold of a scope
+//
+// Parameters:
+//   - excess: the number of failures beyond the threshold (zero for the failure reaching it)
+//
+// Returns:
+//   - `time.Duration`: the initial lockout doubled for every excess failure (capped at the maximum lockout)
+func (throttle *mongoLoginThrottle) lockoutAfter(excess int) time.Duration {
+	lockout := throttle.lockout
+	for range excess {
+		if lockout >= throttle.maxLockout {
+			break
+		}
+		lockout *= 2
+	}
+	return min(lockout, throttle.maxLockout)
+}
+
+// Function `(*mongoLoginThrottle).scopes` determines the scopes a login attempt is counted against
+//
+// Parameters:
+//   - email: the login email of the attempt
+//   - client: the address of the client making the attempt (omitted when empty)
+//
+// Returns:
+//   - `[]loginScope`: the scopes of the attempt
+func (throttle *mongoLoginThrottle) scopes(email string, client string) []loginScope {
+	scopes := []loginScope{{id: "account:" + email, threshold: throttle.accountFailures}}
+	if client != "" {
+		scopes = append(scopes, loginScope{id: "client:" + client, threshold: throttle.clientFailures})
+	}
+	return scopes
+}
+
+// Function `(*mongoLoginThrottle).collection` resolves the login attempt collection through a session independent of the request transaction
+//
+// Parameters:
+//   - ctx: the request context holding the database session
+//
+// Returns:
+//   - `*mongo.Collection`: the login attempt collection
+//   - `context.Context`: the context holding the independent session (writes persist even when the request transaction is rolled back)
+//   - `func()`: function ending the independent session
+//   - `error`: issue starting the independent session (nil if no issue occurred)
+func (throttle *mongoLoginThrottle) collection(ctx context.Context) (*mongo.Collection, context.Context, func(), error) {
+	detachedCtx, end, err := detachedSession(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sess, _ := dbx.MongoFromContext(detachedCtx)
+	coll := sess.Client().
+		Database(models.LoginAttemptQueryContext.Database).
+		Collection(models.LoginAttemptQueryContext.Collection)
+	return coll, detachedCtx, end, nil
+}
+
+// Function `scopeIDs` lists the identifiers of the given scopes
+//
+// Parameters:
+//   - scopes: the scopes to list
+//
+// Returns:
+//   - `[]string`: the identifier of each scope
+func scopeIDs(scopes []loginScope) []string {
+	ids := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		ids = append(ids, scope.id)
+	}
+	return ids
+}
+
+// Function `loginAttemptOf` loads the login throttle and the identity of the login attempt from the workspace
+//
+// Parameters:
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `*mongoLoginThrottle`: the login throttle (nil when none is configured)
+//   - `string`: the login email of the attempt (that of the loaded account record when no login request was bound)
+//   - `string`: the address of the client making the attempt
+//   - `error`: issue reading the workspace (nil if no issue occurred)
+func loginAttemptOf(space *handlerutil.HandlerWorkspace) (*mongoLoginThrottle, string, string, error) {
+	var throttle *mongoLoginThrottle
+	var req models.AuthenticationRequest
+	var acct models.UserAccount
+	var client models.ClientDetails
+
+	if err := space.Get(loginThrottleKey, &throttle); err != nil {
+		if errors.Is(err, handlerutil.ErrKeyNotExists) {
+			return nil, "", "", nil
+		}
+		return nil, "", "", err
+	}
+	if err := space.Get(authRequestKey, &req); errors.Is(err, handlerutil.ErrKeyNotExists) {
+		if err := space.Get(userAccountRecordKey, &acct); err != nil {
+			return nil, "", "", err
+		}
+		req.Email = acct.LoginEmail
+	} else if err != nil {
+		return nil, "", "", err
+	}
+	if err := space.Get(requestClientKey, &client); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		return nil, "", "", err
+	}
+	return throttle, req.Email, client.IPAddress, nil
+}
+
+// Function `checkLoginThrottle` refuses login attempts for accounts or from clients locked out by repeated failures
+//
+// The step does nothing when no login throttle is configured.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: a lockout matching `ErrTooManyLoginAttempts` if the attempt is refused (or another error that occurred during this processing step)
+func checkLoginThrottle(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	log.Printf("[HANDLER]: loading login throttle and attempt details from workspace...")
+	throttle, email, client, err := loginAttemptOf(space)
+	if err != nil {
+		log.Printf("[HANDLER]: error loading login attempt details (%s)", err.Error())
+		return err
+	}
+
+	if throttle == nil {
+		log.Printf("[HANDLER]: no login throttle configured, skipping lockout check")
+		return nil
+	}
+
+	wait, err := throttle.Check(ctx, email, client)
+	if err != nil {
+		log.Printf("[HANDLER]: error checking login lockouts (%s)", err.Error())
+		return err
+	}
+
+	if wait > 0 {
+		log.Printf("[HANDLER]: login attempt refused, lockout ends in %s", wait)
+		return loginLockoutError{retryAfter: wait}
+	}
+	return nil
+}
+
+// Function `countingLoginFailures` creates a processing step that records a failed login when the given step rejects the attempt
+//
+// Unknown login emails count as failures too (and are reported as `ErrInvalidLogin`) so probing for accounts is throttled alike.
+//
+// Parameters:
+//   - step: the processing step that may reject the login attempt
+//
+// Returns:
+//   - `func(context.Context, *handlerutil.HandlerWorkspace) error`: the processing step recording failures of the given step
+func countingLoginFailures(step handlerutil.TransitionFn) func(context.Context, *handlerutil.HandlerWorkspace) error {
+	return func(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+		err := step(ctx, space)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = ErrInvalidLogin
+		}
+		if !errors.Is(err, ErrInvalidLogin) {
+			return err
+		}
+
+		throttle, email, client, lerr := loginAttemptOf(space)
+		if lerr != nil {
+			log.Printf("[HANDLER]: error loading login attempt details (%s)", lerr.Error())
+			return lerr
+		}
+
+		if throttle == nil {
+			return err
+		}
+
+		log.Printf("[HANDLER]: recording failed login attempt...")
+		wait, rerr := throttle.RecordFailure(ctx, email, client)
+		if rerr != nil {
+			log.Printf("[HANDLER]: error recording failed login attempt (%s)", rerr.Error())
+			return rerr
+		}
+
+		if wait > 0 {
+			log.Printf("[HANDLER]: failed login attempt caused a lockout of %s", wait)
+			return loginLockoutError{retryAfter: wait}
+		}
+		return err
+	}
+}
+
+// Function `resetLoginThrottle` forgets the failed logins of the account and client of a successful login
+//
+// The step does nothing when no login throttle is configured.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func resetLoginThrottle(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	log.Printf("[HANDLER]: loading login throttle and attempt details from workspace...")
+	throttle, email, client, err := loginAttemptOf(space)
+	if err != nil {
+		log.Printf("[HANDLER]: error loading login attempt details (%s)", err.Error())
+		return err
+	}
+
+	if throttle == nil {
+		return nil
+	}
+
+	log.Printf("[HANDLER]: resetting failed login attempts...")
+	if err = throttle.Reset(ctx, email, client); err != nil {
+		log.Printf("[HANDLER]: error resetting failed login attempts (%s)", err.Error())
+		return err
+	}
+	return nil
+}
+
+// Function `isLoginFailureError` determines if the given error is a rejected or refused login attempt
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isLoginFailureError(e error) (error, bool) {
+	if lockout, ok := errors.AsType[loginLockoutError](e); ok {
+		return handlerutil.ErrTooManyRequests(
+			handlerutil.NewDetail("login", e.Error()),
+		).WithRetryAfter(lockout.retryAfter), true
+	}
+	if errors.Is(e, ErrInvalidLogin) {
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("credentials", e.Error()),
+		), true
+	}
+	return nil, false
+}
@@ -0,0 +1,198 @@
+package core
+
+/*
+ * File: pkg/core/throttle_test.go
+ *
+ * Purpose: unit tests for failed login tracking and lockouts
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func findLoginAttemptsOk(records ...any) bson.D {
+	return bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "cursor", Value: bson.D{
+			{Key: "id", Value: int64(0)},
+			{Key: "ns", Value: "tournabyte.login_attempts"},
+			{Key: "firstBatch", Value: append(bson.A{}, records...)},
+		}},
+	}
+}
+
+func loginFailureRecorded(failures int) bson.D {
+	return bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 1}}},
+		{Key: "value", Value: bson.M{
+			"_id":        "account:testuser@example.io",
+			"failures":   failures,
+			"expires_at": time.Now().UTC().Add(time.Hour),
+		}},
+	}
+}
+
+func setupLoginThrottleWorkspace(t *testing.T, password string) *handlerutil.HandlerWorkspace {
+	t.Helper()
+	space := setupWorkingUserCreationWorkspace(t)
+
+	bindBodyTo(space, models.AuthenticationRequest{Email: "testuser@example.io", Password: password})
+	space.Set(loginThrottleKey, newMongoLoginThrottle(2, 10, time.Minute, time.Hour, time.Hour))
+	space.Set(requestClientKey, models.ClientDetails{IPAddress: "192.0.2.1"})
+	return space
+}
+
+func TestLoginThrottleLockout(t *testing.T) {
+	throttle := newMongoLoginThrottle(0, 0, 30*time.Second, 5*time.Minute, 0)
+
+	assert.Equal(t, 30*time.Second, throttle.lockoutAfter(0))
+	assert.Equal(t, time.Minute, throttle.lockoutAfter(1))
+	assert.Equal(t, 5*time.Minute, throttle.lockoutAfter(4))
+	assert.Equal(t, 5*time.Minute, throttle.lockoutAfter(1000))
+}
+
+func TestUserAuthenticationThrottled(t *testing.T) {
+	t.Run("LockedOutAttemptRefused", func(t *testing.T) {
+		locked := bson.M{
+			"_id":          "account:testuser@example.io",
+			"failures":     7,
+			"locked_until": time.Now().UTC().Add(time.Minute),
+			"expires_at":   time.Now().UTC().Add(time.Hour),
+		}
+		pCtx, pCancel, pIn, pOut := userAuthenticationPipeline(setupWorkingAdminContext(t, findLoginAttemptsOk(locked)))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupLoginThrottleWorkspace(t, "s3cr3tk3y")
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		require.ErrorIs(t, context.Cause(pCtx), ErrTooManyLoginAttempts)
+
+		failure, matched := isLoginFailureError(context.Cause(pCtx))
+		require.True(t, matched)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		handlerutil.RespondWithError(c, failure)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("FailedAttemptRecorded", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := userAuthenticationPipeline(setupWorkingAdminContext(t, findLoginAttemptsOk(), findUserOk, loginFailureRecorded(1), loginFailureRecorded(1)))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupLoginThrottleWorkspace(t, "wrongpassword")
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidLogin)
+	})
+
+	t.Run("UnknownEmailRecorded", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := userAuthenticationPipeline(setupWorkingAdminContext(t, findLoginAttemptsOk(), findNoUserOk, loginFailureRecorded(1), loginFailureRecorded(1)))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupLoginThrottleWorkspace(t, "s3cr3tk3y")
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidLogin)
+	})
+
+	t.Run("ThresholdReachedLocksOut", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := userAuthenticationPipeline(setupWorkingAdminContext(t, findLoginAttemptsOk(), findUserOk, loginFailureRecorded(2), updateOneOk, loginFailureRecorded(2)))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupLoginThrottleWorkspace(t, "wrongpassword")
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrTooManyLoginAttempts)
+	})
+
+	t.Run("SuccessfulAttemptResets", func(t *testing.T) {
+		pCtx, pCancel, pIn, pOut := userAuthenticationPipeline(setupWorkingAdminContext(t, findLoginAttemptsOk(), findUserOk, deleteManyOk, insertOk))
+		var result models.AuthenticatedUser
+		defer close(pIn)
+		defer pCancel(nil)
+
+		pIn <- setupLoginThrottleWorkspace(t, "s3cr3tk3y")
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		require.NoError(t, after.Get(userAuthorizationResponseKey, &result))
+
+		assert.NotZero(t, result.AccessToken)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+}
+
+func TestForwardedClientAddress(t *testing.T) {
+	clientIPOf := func(t *testing.T, trusted []string) string {
+		t.Helper()
+		var cfg models.ApplicationOptions
+		cfg.Serve.Security.TrustedProxies = trusted
+		router, err := routerFromConfig(&cfg)
+		require.NoError(t, err)
+
+		var seen string
+		router.GET("/", func(ctx *gin.Context) { seen = ctx.ClientIP() })
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "10.0.0.7:41234"
+		req.Header.Set("X-Forwarded-For", "203.0.113.9")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		return seen
+	}
+
+	t.Run("UntrustedForwardingIgnored", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.7", clientIPOf(t, nil))
+	})
+
+	t.Run("TrustedProxyForwardingHonoured", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.9", clientIPOf(t, []string{"10.0.0.0/8"}))
+	})
+
+	t.Run("InvalidProxyRejected", func(t *testing.T) {
+		var cfg models.ApplicationOptions
+		cfg.Serve.Security.TrustedProxies = []string{"not-an-address"}
+		_, err := routerFromConfig(&cfg)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,494 @@
+package core
+
+/*
+ * File: pkg/core/verification.go
+ *
+ * Purpose: email verification logic
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"github.com/tournabyte/webapi/pkg/notify"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Duration an email verification token remains valid when not configured
+const defaultEmailVerificationTTL = 24 * time.Hour
+
+// Workspace keys associated with email verification workspace tasks
+const (
+	emailVerificationRequestKey  = "emailVerificationRequest"
+	emailVerificationOptionsKey  = "emailVerificationOptions"
+	emailVerificationTokenKey    = "emailVerificationToken"
+	emailVerificationResponseKey = "emailVerificationResponse"
+	redeemedVerificationKey      = "redeemedEmailVerification"
+)
+
+// Errors specific to email verification workflow tasks
+var (
+	ErrEmailNotVerified              = errors.New("a verified email is required for this action")
+	ErrEmailAlreadyVerified          = errors.New("email is already verified")
+	ErrInvalidEmailVerificationToken = errors.New("email verification token is invalid or expired")
+)
+
+// Function `(*tournabyteAPIService).initEmailVerificationWorkspace` initializes the handler workspace for an email verification request handling sequence
+//
+// Parameters:
+//   - ctx: the request context to use during workspace initialization
+//
+// Returns:
+//   - `*handlerutil.HandlerWorkspace`: the workspace for verifying (or requesting verification of) a login email
+func (srv *tournabyteAPIService) initEmailVerificationWorkspace(ctx *gin.Context) *handlerutil.HandlerWorkspace {
+	space := handlerutil.DefaultWorkspace()
+	binds := handlerutil.BindingsFromRequestContext(ctx, handlerutil.ShouldHaveJSONBody|handlerutil.ShouldHaveHeaders)
+
+	space.Set(handlerutil.RequestBindings, binds)
+	space.Set(authTokenOptionsKey, srv.getTokenConfig())
+	space.Set(emailVerificationOptionsKey, srv.getEmailVerificationConfig())
+	space.Set(models.ValidatorObjectKey, srv.validationFunc)
+	log.Printf("[HANDLER]: setup request bindings and email verification configurations")
+	return &space
+}
+
+// Function `emailVerificationPipeline` initializes a handling pipeline for confirming ownership of a login email
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func emailVerificationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindEmailVerificationRequest, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, consumeEmailVerificationToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, markEmailVerified, out2)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, confirmEmailVerified, out3)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `resendEmailVerificationPipeline` initializes a handling pipeline for delivering a new verification token to the current user
+//
+// Parameters:
+//   - ctx: the parent context to control the created pipeline
+//
+// Returns:
+//   - `context.Context`: the context controlling the created pipeline (derived from the given context.Context)
+//   - `context.CancelCauseFunc`: the cancellation function controlling pipeline cancellation
+//   - `chan<- *handlerutil.HandlerWorkspace`: the input channel for the pipeline (send-only)
+//   - `<-chan *handlerutil.HandlerWorkspace`: the output channel for the pipeline (read-only)
+func resendEmailVerificationPipeline(ctx context.Context) (context.Context, context.CancelCauseFunc, chan<- *handlerutil.HandlerWorkspace, <-chan *handlerutil.HandlerWorkspace) {
+	pipelineCtx, pipelineCancel := context.WithCancelCause(ctx)
+	pipelineInput := make(chan *handlerutil.HandlerWorkspace)
+
+	out1 := handlerutil.Stage(pipelineCtx, pipelineCancel, bindAccessTokenFromHeader, pipelineInput)
+	out2 := handlerutil.Stage(pipelineCtx, pipelineCancel, validateAccessToken, out1)
+	out3 := handlerutil.Stage(pipelineCtx, pipelineCancel, fetchAccountRecordOfActiveUser, out2)
+	out4 := handlerutil.Stage(pipelineCtx, pipelineCancel, requireUnverifiedEmail, out3)
+	out5 := handlerutil.Stage(pipelineCtx, pipelineCancel, createEmailVerificationToken, out4)
+	out6 := handlerutil.Stage(pipelineCtx, pipelineCancel, deliverEmailVerificationToken, out5)
+	pipelineOutput := handlerutil.Stage(pipelineCtx, pipelineCancel, confirmEmailVerificationSent, out6)
+
+	return pipelineCtx, pipelineCancel, pipelineInput, pipelineOutput
+}
+
+// Function `bindEmailVerificationRequest` binds the request body and saves it to the handler workspace for later processing
+//
+// Parameters:
+//   - ctx: the context managing the handler lifecycle
+//   - space: the handler workspace for the email verification process
+//
+// Returns:
+//   - `error`: error that occurred during this step of the pipeline
+func bindEmailVerificationRequest(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var body models.EmailVerificationRequest
+	var bindings handlerutil.Bindings
+
+	log.Printf("[HANDLER]: loading request bindings from workspace...")
+	if err := space.Get(handlerutil.RequestBindings, &bindings); err != nil {
+		log.Printf("[HANDLER]: error loading request bindings from workspace (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: binding request body to variable of type %T", body)
+	if err := bindings.BindBodyAsJSON(&body); err != nil {
+		log.Printf("[HANDLER]: error binding request body (%s)", err.Error())
+		return err
+	}
+
+	space.Set(emailVerificationRequestKey, body)
+	log.Printf("[HANDLER]: saved request body as variable of type %T within workspace under key %q", body, emailVerificationRequestKey)
+	return nil
+}
+
+// Function `requireVerifiedEmail` rejects requests whose access token was issued to an account without a verified login email
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrEmailNotVerified` if the login email is not verified (nil otherwise)
+func requireVerifiedEmail(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var verified bool
+
+	log.Printf("[HANDLER]: loading email verification status within access token under %q...", activeUserVerified)
+	if err := space.Get(activeUserVerified, &verified); err != nil && !errors.Is(err, handlerutil.ErrKeyNotExists) {
+		log.Printf("[HANDLER]: error loading email verification status (%s)", err.Error())
+		return err
+	}
+
+	if !verified {
+		log.Printf("[HANDLER]: login email of token user is not verified, rejecting request")
+		return ErrEmailNotVerified
+	}
+	return nil
+}
+
+// Function `requireUnverifiedEmail` rejects requests for verification tokens by accounts whose login email is already verified
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrEmailAlreadyVerified` if the login email is verified (nil otherwise)
+func requireUnverifiedEmail(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err := space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	if acct.EmailVerified {
+		log.Printf("[HANDLER]: login email of account (_id=%q) is already verified", acct.ID.Hex())
+		return ErrEmailAlreadyVerified
+	}
+	return nil
+}
+
+// Function `createEmailVerificationToken` issues a single-use verification token for the login email of the account within the workspace
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func createEmailVerificationToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var acct models.UserAccount
+	var opts models.EmailVerificationOptions
+	var sess *mongo.Session
+	var cfg *options.InsertOneOptionsBuilder
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading email verification options from workspace under %q key...", emailVerificationOptionsKey)
+	if err = space.Get(emailVerificationOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading email verification options (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: generating email verification token...")
+	raw := rand.Text()
+	record := models.EmailVerificationToken{
+		ID:            fmt.Sprintf("%x", sha256.Sum256([]byte(raw))),
+		Authorizes:    acct.ID,
+		Email:         acct.LoginEmail,
+		NotValidAfter: now.Add(cmp.Or(opts.ExpiresIn, defaultEmailVerificationTTL)),
+		CreatedAt:     now,
+	}
+
+	log.Printf("[HANDLER]: loading database operation settings...")
+	if cfg, err = dbx.NewOptions(dbx.ValidateInsertedDocument(true)); err != nil {
+		log.Printf("[HANDLER]: error configuration database operation (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database insertion operation")
+	if _, err = sess.Client().
+		Database(models.EmailVerificationQueryContext.Database).
+		Collection(models.EmailVerificationQueryContext.Collection).
+		InsertOne(ctx, record, cfg); err != nil {
+		log.Printf("[HANDLER]: error during database insertion operation (%s)", err.Error())
+		return err
+	}
+
+	space.Set(emailVerificationTokenKey, raw)
+	log.Printf("[HANDLER]: email verification token issued (authorizes=%q, expires=%s)", acct.ID.Hex(), record.NotValidAfter)
+	return nil
+}
+
+// Function `deliverEmailVerificationToken` sends the verification token within the workspace to the login email of the account
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func deliverEmailVerificationToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var raw string
+	var acct models.UserAccount
+	var opts models.EmailVerificationOptions
+	var err error
+
+	log.Printf("[HANDLER]: loading email verification token from workspace under %q key...", emailVerificationTokenKey)
+	if err = space.Get(emailVerificationTokenKey, &raw); err != nil {
+		log.Printf("[HANDLER]: error loading email verification token (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading account record from workspace...")
+	if err = space.Get(userAccountRecordKey, &acct); err != nil {
+		log.Printf("[HANDLER]: error loading account record (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading email verification options from workspace under %q key...", emailVerificationOptionsKey)
+	if err = space.Get(emailVerificationOptionsKey, &opts); err != nil {
+		log.Printf("[HANDLER]: error loading email verification options (%s)", err.Error())
+		return err
+	}
+
+	msg := notify.Message{
+		Recipient: acct.LoginEmail,
+		Subject:   "Verify your Tournabyte email",
+		Body: fmt.Sprintf(
+			"Welcome to Tournabyte!\n\n"+
+				"Use the following token to verify your email: %s\n\n"+
+				"The token expires in %s. If you did not create an account, you can ignore this message.\n",
+			raw,
+			cmp.Or(opts.ExpiresIn, defaultEmailVerificationTTL),
+		),
+	}
+
+	log.Printf("[HANDLER]: delivering email verification token...")
+	if err = opts.Notifier.Notify(ctx, msg); err != nil {
+		log.Printf("[HANDLER]: error delivering email verification token (%s)", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Function `consumeEmailVerificationToken` removes the unexpired verification token matching the one presented and saves it to the workspace
+//
+// Removing the token as part of the lookup ensures each token is only ever redeemed once.
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidEmailVerificationToken` if no unexpired token matches (or another error that occurred during this processing step)
+func consumeEmailVerificationToken(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var req models.EmailVerificationRequest
+	var record models.EmailVerificationToken
+	var sess *mongo.Session
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading email verification request from workspace under key %q", emailVerificationRequestKey)
+	if err = space.Get(emailVerificationRequestKey, &req); err != nil {
+		log.Printf("[HANDLER]: error loading email verification request (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: performing database removal operation")
+	filter := bson.D{
+		{Key: "_id", Value: fmt.Sprintf("%x", sha256.Sum256([]byte(req.Token)))},
+		{Key: "not_valid_after", Value: bson.D{{Key: "$gt", Value: now}}},
+	}
+	err = sess.Client().
+		Database(models.EmailVerificationQueryContext.Database).
+		Collection(models.EmailVerificationQueryContext.Collection).
+		FindOneAndDelete(ctx, filter).
+		Decode(&record)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		log.Printf("[HANDLER]: no unexpired email verification token matches the one presented")
+		return ErrInvalidEmailVerificationToken
+	}
+	if err != nil {
+		log.Printf("[HANDLER]: error performing database removal (%s)", err.Error())
+		return err
+	}
+
+	space.Set(redeemedVerificationKey, record)
+	log.Printf("[HANDLER]: saved redeemed token as variable of type %T within workspace under key %q", record, redeemedVerificationKey)
+	return nil
+}
+
+// Function `markEmailVerified` marks the login email named by the redeemed verification token as verified
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: `ErrInvalidEmailVerificationToken` if the account no longer uses the verified email (or another error that occurred during this processing step)
+func markEmailVerified(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	var record models.EmailVerificationToken
+	var sess *mongo.Session
+	var res *mongo.UpdateResult
+	var now = time.Now().UTC()
+	var err error
+
+	log.Printf("[HANDLER]: loading redeemed token from workspace under key %q", redeemedVerificationKey)
+	if err = space.Get(redeemedVerificationKey, &record); err != nil {
+		log.Printf("[HANDLER]: error loading redeemed token (%s)", err.Error())
+		return err
+	}
+
+	log.Printf("[HANDLER]: loading database session from request context...")
+	if sess, err = dbx.MongoFromContext(ctx); err != nil {
+		log.Printf("[HANDLER]: error loading database session from request context (%s)", err.Error())
+		return err
+	}
+
+	log.Print("[HANDLER]: running database update operation...")
+	res, err = sess.Client().
+		Database(models.UserAccountQueryContext.Database).
+		Collection(models.UserAccountQueryContext.Collection).
+		UpdateOne(
+			ctx,
+			bson.D{{Key: "_id", Value: record.Authorizes}, {Key: "login_email", Value: record.Email}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "email_verified", Value: true}, {Key: "metadata.updated_at", Value: now}}}},
+		)
+
+	if err != nil {
+		log.Printf("[HANDLER]: error during database update operation (%s)", err.Error())
+		return err
+	}
+
+	if res.MatchedCount != 1 {
+		log.Printf("[HANDLER]: account (_id=%q) no longer uses the verified email", record.Authorizes.Hex())
+		return ErrInvalidEmailVerificationToken
+	}
+
+	log.Printf("[HANDLER]: login email of account (_id=%q) verified", record.Authorizes.Hex())
+	return nil
+}
+
+// Function `confirmEmailVerified` populates the response for a completed email verification
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func confirmEmailVerified(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	log.Printf("[HANDLER]: saved response structure to workspace")
+	space.Set(emailVerificationResponseKey, gin.H{"emailVerified": time.Now().UTC()})
+	return nil
+}
+
+// Function `confirmEmailVerificationSent` populates the response for a delivered verification token
+//
+// Parameters:
+//   - ctx: the context managing the lifecycle of this handler
+//   - space: the workspace to utilize
+//
+// Returns:
+//   - `error`: error that occurred during this processing step
+func confirmEmailVerificationSent(ctx context.Context, space *handlerutil.HandlerWorkspace) error {
+	log.Printf("[HANDLER]: saved response structure to workspace")
+	space.Set(emailVerificationResponseKey, gin.H{"verificationSent": time.Now().UTC()})
+	return nil
+}
+
+// Function `ensureEmailVerificationIndexes` creates the TTL index removing email verification tokens once they expire
+//
+// Parameters:
+//   - ctx: the context holding the database session
+//
+// Returns:
+//   - `error`: issue creating the index (nil if no issue occurred)
+func ensureEmailVerificationIndexes(ctx context.Context) error {
+	sess, err := dbx.MongoFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = sess.Client().
+		Database(models.EmailVerificationQueryContext.Database).
+		Collection(models.EmailVerificationQueryContext.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "not_valid_after", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		})
+	return err
+}
+
+// Function `isEmailVerificationError` determines if the given error is related to email verification
+//
+// Parameters:
+//   - e: the error to classify
+//
+// Returns:
+//   - `error`: the corresponding failure message (nil if the match condition is not satisfied)
+//   - `bool`: whether the match condition of the given error was satisfied
+func isEmailVerificationError(e error) (error, bool) {
+	switch {
+	case errors.Is(e, ErrEmailNotVerified):
+		return handlerutil.ErrNoAccess(
+			handlerutil.NewDetail("email", e.Error()),
+		), true
+	case errors.Is(e, ErrEmailAlreadyVerified):
+		return handlerutil.ErrConstraintsNotSatisfied(
+			handlerutil.NewDetail("email", e.Error()),
+		), true
+	case errors.Is(e, ErrInvalidEmailVerificationToken):
+		return handlerutil.ErrNotAuthorized(
+			handlerutil.NewDetail("token", e.Error()),
+		), true
+	}
+	return nil, false
+}
@@ -0,0 +1,156 @@
+package core
+
+/*
+ * File: pkg/core/verification_test.go
+ *
+ * Purpose: unit tests for the email verification pipelines
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournabyte/webapi/pkg/handlerutil"
+	"github.com/tournabyte/webapi/pkg/models"
+	"github.com/tournabyte/webapi/pkg/notify"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	consumeVerificationTokenOk = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "lastErrorObject", Value: bson.D{{Key: "n", Value: 1}}},
+		{Key: "value", Value: bson.M{
+			"_id":             "abc123",
+			"authorizes":      bson.NewObjectID(),
+			"email":           "testuser@example.io",
+			"not_valid_after": time.Now().UTC().Add(time.Minute),
+			"created_at":      time.Now().UTC(),
+		}},
+	}
+	updateOneNoMatch = bson.D{
+		{Key: "ok", Value: 1},
+		{Key: "n", Value: 0},
+		{Key: "nModified", Value: 0},
+	}
+)
+
+func setupEmailVerificationOptions(space *handlerutil.HandlerWorkspace, sent *[]notify.Message) {
+	space.Set(emailVerificationOptionsKey, models.EmailVerificationOptions{
+		ExpiresIn: time.Minute,
+		Notifier: notify.NotifierFunc(func(ctx context.Context, msg notify.Message) error {
+			*sent = append(*sent, msg)
+			return nil
+		}),
+	})
+}
+
+func TestEmailVerificationPipeline(t *testing.T) {
+	t.Run("EmailVerified", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := emailVerificationPipeline(setupWorkingAdminContext(t, consumeVerificationTokenOk, updateOneOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := handlerutil.DefaultWorkspace()
+		bindBodyTo(&space, models.EmailVerificationRequest{Token: "abc123"})
+		setupEmailVerificationOptions(&space, &sent)
+		pIn <- &space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+		assert.NoError(t, after.Get(emailVerificationResponseKey, new(map[string]any)))
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+
+	t.Run("UnknownTokenRejected", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := emailVerificationPipeline(setupWorkingAdminContext(t, consumeResetTokenMissing))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := handlerutil.DefaultWorkspace()
+		bindBodyTo(&space, models.EmailVerificationRequest{Token: "abc123"})
+		setupEmailVerificationOptions(&space, &sent)
+		pIn <- &space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidEmailVerificationToken)
+	})
+
+	t.Run("ChangedEmailRejected", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := emailVerificationPipeline(setupWorkingAdminContext(t, consumeVerificationTokenOk, updateOneNoMatch))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := handlerutil.DefaultWorkspace()
+		bindBodyTo(&space, models.EmailVerificationRequest{Token: "abc123"})
+		setupEmailVerificationOptions(&space, &sent)
+		pIn <- &space
+
+		_, ok := <-pOut
+		require.False(t, ok, "Pipeline should not produce a result")
+
+		<-pCtx.Done()
+		assert.ErrorIs(t, context.Cause(pCtx), ErrInvalidEmailVerificationToken)
+	})
+}
+
+func TestResendEmailVerificationPipeline(t *testing.T) {
+	t.Run("VerificationTokenDelivered", func(t *testing.T) {
+		var sent []notify.Message
+		pCtx, pCancel, pIn, pOut := resendEmailVerificationPipeline(setupWorkingAdminContext(t, findUserOk, insertOk))
+		defer close(pIn)
+		defer pCancel(nil)
+
+		space := setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+		setupEmailVerificationOptions(space, &sent)
+		pIn <- space
+
+		after, ok := <-pOut
+		require.True(t, ok, "Reading value from pipeline exit channel failed")
+
+		var raw string
+		require.NoError(t, after.Get(emailVerificationTokenKey, &raw))
+		require.Len(t, sent, 1)
+		assert.Equal(t, "testuser@example.io", sent[0].Recipient)
+		assert.Contains(t, sent[0].Body, raw)
+
+		select {
+		case <-pCtx.Done():
+			require.NoError(t, context.Cause(pCtx))
+		default:
+		}
+	})
+}
+
+func TestUnverifiedEmailRejected(t *testing.T) {
+	pCtx, pCancel, pIn, pOut := eventCreationPipeline(setupWorkingAdminContext(t))
+	defer close(pIn)
+	defer pCancel(nil)
+
+	pIn <- setupWorkingAdminWorkspace(t, models.AccountRoleUser, nil, nil)
+
+	_, ok := <-pOut
+	require.False(t, ok, "Pipeline should not produce a result")
+
+	<-pCtx.Done()
+	assert.ErrorIs(t, context.Cause(pCtx), ErrEmailNotVerified)
+}
@@ -11,7 +11,10 @@ package handlerutil
  *
  */
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 // Constant error messages to display when handler functions encouter a failure
 const (
@@ -20,6 +23,7 @@ const (
 	failedToAuthorizeRequest   = "You don't appear to have permission to access that."
 	failedToReachUpstreamData  = "I've having trouble reaching an operating partner."
 	failedToSatisfyConstraints = "I can't fulfill this request or else bad things will happen."
+	failedToKeepUp             = "Whoa, slow down! Give me a moment before trying that again."
 	failedToRunWithoutIssue    = "I'm so dumb, I should just exec `$ rm -rf /` myself"
 )
 
@@ -31,6 +35,7 @@ var (
 	ErrUpstreamUnreachable     = handlerFailureFactory(http.StatusBadGateway, failedToReachUpstreamData)
 	ErrInternalServerError     = handlerFailureFactory(http.StatusInternalServerError, failedToRunWithoutIssue)
 	ErrConstraintsNotSatisfied = handlerFailureFactory(http.StatusConflict, failedToSatisfyConstraints)
+	ErrTooManyRequests         = handlerFailureFactory(http.StatusTooManyRequests, failedToKeepUp)
 )
 
 // Type `HandlerFailureFormatter` utilizes predicate-based matching rules to format errors as `handlerFailure` instances
@@ -105,10 +110,12 @@ func NewDetail(detailName string, detailInfo string) errorDetail {
 //   - statusCode: the HTTP status code that is associated with the failure
 //   - errmsg: the top-level issue that was encountered during handler execution
 //   - details: additional information regarding the failure
+//   - retryAfter: how long the client should wait before repeating the request (zero if unspecified)
 type handlerFailure struct {
 	statusCode int
 	Message    string
 	Details    []errorDetail
+	retryAfter time.Duration
 }
 
 // Function `handlerFailure.Error` implements the error interface for the handlerFailure type
@@ -116,6 +123,18 @@ func (f handlerFailure) Error() string {
 	return f.Message
 }
 
+// Function `handlerFailure.WithRetryAfter` creates a copy of this failure advising the client to wait before repeating the request
+//
+// Parameters:
+//   - wait: how long the client should wait before repeating the request
+//
+// Returns:
+//   - `handlerFailure`: the failure advertising the wait through the `Retry-After` response header
+func (f handlerFailure) WithRetryAfter(wait time.Duration) handlerFailure {
+	f.retryAfter = wait
+	return f
+}
+
 // Function `(*handlerFailure).DetailMapping` creates a name->info mapping of this details associated with this failure
 //
 // Returns:
@@ -13,6 +13,8 @@ package handlerutil
 
 import (
 	"errors"
+	"math"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -67,6 +69,9 @@ func RespondWithError(ctx *gin.Context, err error) {
 				"details": failure.DetailMapping(),
 			},
 		}
+		if failure.retryAfter > 0 {
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(failure.retryAfter.Seconds()))))
+		}
 		ctx.AbortWithStatusJSON(failure.statusCode, body)
 		ctx.Error(err)
 	} else {
@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +32,9 @@ func setupResponseTestRouter() *gin.Engine {
 	router.GET("/fail", func(ctx *gin.Context) {
 		handlerutil.RespondWithError(ctx, handlerutil.ErrBadRequest())
 	})
+	router.GET("/throttled", func(ctx *gin.Context) {
+		handlerutil.RespondWithError(ctx, handlerutil.ErrTooManyRequests().WithRetryAfter(1500*time.Millisecond))
+	})
 	router.GET("/error", func(ctx *gin.Context) {
 		handlerutil.RespondWithError(ctx, errors.New("unanticipated error"))
 	})
@@ -67,6 +71,26 @@ func TestResponseFormatting(t *testing.T) {
 		assert.Contains(t, responseBody, `"error":`)
 	})
 
+	t.Run("GotThrottled", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/throttled", nil)
+
+		server.ServeHTTP(w, r)
+		statusCode := w.Code
+
+		assert.Equal(t, http.StatusTooManyRequests, statusCode)
+		assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("GotFailureWithoutRetryAfter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/fail", nil)
+
+		server.ServeHTTP(w, r)
+
+		assert.Empty(t, w.Header().Get("Retry-After"))
+	})
+
 	t.Run("GotError", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		r := httptest.NewRequest("GET", "/error", nil)
@@ -0,0 +1,74 @@
+package models
+
+/*
+ * File: pkg/models/admin.go
+ *
+ * Purpose: data models for platform administration
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import "time"
+
+// Type `UserID` represents the request URI for looking up a user account
+//
+// Fields:
+//   - ID: the user account ID
+type UserID struct {
+	ID string `json:"id" uri:"userid" binding:"required,mongodb"`
+}
+
+// Type `ListUsersRequest` represents the request query parameters for the admin list users endpoint
+//
+// Fields:
+//   - Search: only include accounts whose login email contains this text (case-insensitive)
+//   - Status: only include active or deactivated accounts
+//   - Limit: the maximum number of accounts per page (defaults to `DefaultListLimit`)
+//   - Cursor: the opaque position of the page to fetch (from a previous response, omitted for the first page)
+type ListUsersRequest struct {
+	Search string `form:"q" binding:"max=128"`
+	Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+	Limit  int64  `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor" binding:"omitempty,base64url"`
+}
+
+// Type `UserAccountSummary` represents the details of a user account shown to administrators
+//
+// Fields:
+//   - ID: the user account ID
+//   - Email: the login email of the account
+//   - Role: the platform-wide role of the account
+//   - Active: whether the account can sign in
+//   - CreatedAt: the timestamp of account creation
+type UserAccountSummary struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Type `UserListResponse` represents a page of a user account listing
+//
+// Fields:
+//   - Users: the accounts on this page
+//   - Next: the cursor for the following page (omitted on the last page)
+type UserListResponse struct {
+	Users []UserAccountSummary `json:"users"`
+	Next  string               `json:"next,omitempty"`
+}
+
+// Type `AccountModerationResponse` represents a response to a successful moderation action on a user account
+//
+// Fields:
+//   - ID: the moderated user account ID
+//   - Active: whether the account can sign in after the action
+//   - SessionsClosed: the number of sessions of the account closed by the action
+type AccountModerationResponse struct {
+	ID             string `json:"id"`
+	Active         bool   `json:"active"`
+	SessionsClosed int64  `json:"sessionsClosed"`
+}
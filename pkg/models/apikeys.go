@@ -0,0 +1,87 @@
+package models
+
+/*
+ * File: pkg/models/apikeys.go
+ *
+ * Purpose: data models for API keys used by machine clients
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Variables storing query context associated with API key operations
+var (
+	APIKeyQueryContext = dbx.NewQueryContext(`tournabyte`, `api_keys`)
+)
+
+// Constants storing the scopes an API key can be restricted to
+const (
+	APIKeyScopeRead   = "events:read"
+	APIKeyScopeScore  = "matches:score"
+	APIKeyScopeManage = "events:manage"
+)
+
+// Type `APIKeyRecord` represents a stored API key
+//
+// Fields:
+//   - ID: the API key ID (used to revoke the key)
+//   - Hash: the hash of the key delivered to the owner (the key itself is never stored)
+//   - Hint: the leading characters of the key (for telling keys apart)
+//   - Owner: the user ID the key acts on behalf of
+//   - Name: the name the owner gave the key
+//   - Scopes: the operations the key may perform
+//   - Events: the event IDs the key is restricted to (omitted for keys usable on every event)
+//   - CreatedAt: the timestamp the key was issued
+//   - ExpiresAt: the timestamp the key stops working (omitted for keys that never expire)
+type APIKeyRecord struct {
+	ID        bson.ObjectID   `json:"id" bson:"_id"`
+	Hash      string          `json:"-" bson:"hash"`
+	Hint      string          `json:"hint" bson:"hint"`
+	Owner     bson.ObjectID   `json:"-" bson:"owner"`
+	Name      string          `json:"name" bson:"name"`
+	Scopes    []string        `json:"scopes" bson:"scopes"`
+	Events    []bson.ObjectID `json:"events,omitempty" bson:"events,omitempty"`
+	CreatedAt time.Time       `json:"createdAt" bson:"created_at"`
+	ExpiresAt *time.Time      `json:"expiresAt,omitempty" bson:"expires_at,omitempty"`
+}
+
+// Type `NewAPIKeyRequest` represents the request body for issuing an API key
+//
+// Fields:
+//   - Name: the name to tell the key apart by
+//   - Scopes: the operations the key may perform
+//   - Events: the event IDs to restrict the key to (empty for every event)
+//   - ExpiresIn: the number of days the key remains valid (zero for keys that never expire)
+type NewAPIKeyRequest struct {
+	Name      string   `json:"name" binding:"required,min=1,max=64"`
+	Scopes    []string `json:"scopes" binding:"required,min=1,dive,oneof=events:read matches:score events:manage"`
+	Events    []string `json:"events" binding:"omitempty,dive,mongodb"`
+	ExpiresIn int      `json:"expiresInDays" binding:"omitempty,min=1,max=365"`
+}
+
+// Type `IssuedAPIKey` represents the response body for an issued API key
+//
+// Fields:
+//   - APIKeyRecord: the stored details of the key
+//   - Key: the API key (only ever shown once)
+type IssuedAPIKey struct {
+	APIKeyRecord
+	Key string `json:"key"`
+}
+
+// Type `APIKeyLookupRequest` represents the request URI for looking up an API key of the current user
+//
+// Fields:
+//   - ID: the API key ID
+type APIKeyLookupRequest struct {
+	ID string `uri:"keyid" binding:"required,mongodb"`
+}
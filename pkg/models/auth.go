@@ -12,6 +12,7 @@ package models
  */
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
@@ -21,14 +22,23 @@ import (
 
 // Variables storing query context associated with user account operations
 var (
-	UserAccountQueryContext = dbx.NewQueryContext(`tournabyte`, `users`)
-	UserSessionQueryContext = dbx.NewQueryContext(`tournabyte`, `sessions`)
+	UserAccountQueryContext  = dbx.NewQueryContext(`tournabyte`, `users`)
+	UserSessionQueryContext  = dbx.NewQueryContext(`tournabyte`, `sessions`)
+	SecurityLogQueryContext  = dbx.NewQueryContext(`tournabyte`, `security_log`)
+	RevocationQueryContext   = dbx.NewQueryContext(`tournabyte`, `revoked_tokens`)
+	LoginAttemptQueryContext = dbx.NewQueryContext(`tournabyte`, `login_attempts`)
 )
 
 const (
 	ValidatorObjectKey = "validatorObject"
 )
 
+// Constants storing the platform-wide roles a user account can hold
+const (
+	AccountRoleUser  = "USER"
+	AccountRoleAdmin = "ADMIN"
+)
+
 // Type `AuthenticatedUser` represents the response structure for successfully authenticating as a user
 //
 // Fields:
@@ -45,8 +55,72 @@ type AuthenticatedUser struct {
 //
 // Fields:
 //   - Owner: private claim expected to be the userID of the account this token was issued to
+//   - Role: private claim stating the platform-wide role of the account this token was issued to
+//   - Session: private claim stating the session family this token was issued under
+//   - EmailVerified: private claim stating whether the login email of the account was verified when this token was issued
 type AuthorizationTokenClaims struct {
-	Me string `json:"whoami" validate:"required,mongodb"`
+	Me            string `json:"whoami" validate:"required,mongodb"`
+	Role          string `json:"role,omitempty" validate:"omitempty,oneof=USER ADMIN"`
+	Session       string `json:"sid,omitempty" validate:"omitempty,mongodb"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+}
+
+// Type `TokenIdentity` represents the details of an access token needed to determine whether it was revoked
+//
+// Fields:
+//   - ID: the `jti` claim of the token
+//   - User: the user ID the token was issued to
+//   - Session: the session family the token was issued under (empty if unknown)
+//   - IssuedAt: the `iat` claim of the token
+type TokenIdentity struct {
+	ID       string
+	User     string
+	Session  string
+	IssuedAt time.Time
+}
+
+// Type `TokenRevocationStore` represents storage of access token revocations
+//
+// The `iat` claim has second precision, so user and session revocations apply to every token issued up to and including the second of the
+// revocation time. Tokens minted by the revoking request itself can be listed as exempt by their `jti` claim.
+// Implementations may cache lookups, so a revocation can take a short while to reach every replica.
+type TokenRevocationStore interface {
+	// RevokeUser revokes the access tokens issued to a user up to the given time (except the exempt token IDs)
+	RevokeUser(ctx context.Context, user string, at time.Time, exempt ...string) error
+	// RevokeSession revokes the access tokens issued under a session family up to the given time (except the exempt token IDs)
+	RevokeSession(ctx context.Context, family string, at time.Time, exempt ...string) error
+	// RevokeToken revokes the access token with the given ID until it expires
+	RevokeToken(ctx context.Context, id string, expiresAt time.Time) error
+	// IsRevoked determines whether the given access token was revoked
+	IsRevoked(ctx context.Context, token TokenIdentity) (bool, error)
+}
+
+// Type `TokenRevocation` represents a stored access token revocation
+//
+// Fields:
+//   - ID: the revoked scope (`user:<id>`, `session:<id>` or `token:<jti>`)
+//   - RevokedBefore: tokens of the scope issued before this timestamp (rounded up to the next second) are revoked
+//   - Exempt: the IDs of tokens issued in the last revoked second that remain valid
+//   - ExpiresAt: the timestamp after which every revoked token has expired anyway (the record is removed afterwards)
+type TokenRevocation struct {
+	ID            string    `bson:"_id"`
+	RevokedBefore time.Time `bson:"revoked_before"`
+	Exempt        []string  `bson:"exempt,omitempty"`
+	ExpiresAt     time.Time `bson:"expires_at"`
+}
+
+// Type `LoginAttempts` represents the failed login attempts recorded against an account or client
+//
+// Fields:
+//   - ID: the scope the failures were recorded against (`account:<email>` or `client:<address>`)
+//   - Failures: the number of consecutive failed attempts within the scope
+//   - LockedUntil: the timestamp before which further attempts within the scope are refused
+//   - ExpiresAt: the timestamp the failures are forgotten (the record is removed afterwards)
+type LoginAttempts struct {
+	ID          string    `bson:"_id"`
+	Failures    int       `bson:"failures"`
+	LockedUntil time.Time `bson:"locked_until"`
+	ExpiresAt   time.Time `bson:"expires_at"`
 }
 
 // Type `AuthorizationHeaderContent` represents a key:value pair specifically for the HTTP Authorization header
@@ -81,12 +155,22 @@ type SessionID struct {
 //   - ID: the user account id
 //   - LoginEmail: the email associated with this user's login details
 //   - PasswordHash: the hashed password associated with this user's login details
+//   - Role: the platform-wide role of this user (empty is treated as a regular user)
+//   - EmailVerified: indicates the user proved ownership of the login email
+//   - MFA: the two-factor authentication settings of this user (nil if never enrolled)
+//   - Identities: the external identities linked to this user (empty password hash for users created through one)
+//   - Profile: the self-described details of this user shown to other users (nil if never set)
 //   - Metadata: account document metadata
 type UserAccount struct {
-	ID           bson.ObjectID        `bson:"_id"`
-	LoginEmail   string               `bson:"login_email"`
-	PasswordHash string               `bson:"password_hash"`
-	Metadata     dbx.DocumentMetadata `bson:"metadata"`
+	ID            bson.ObjectID        `bson:"_id"`
+	LoginEmail    string               `bson:"login_email"`
+	PasswordHash  string               `bson:"password_hash"`
+	Role          string               `bson:"role,omitempty"`
+	EmailVerified bool                 `bson:"email_verified"`
+	MFA           *AccountMFA          `bson:"mfa,omitempty"`
+	Identities    []ExternalIdentity   `bson:"identities,omitempty"`
+	Profile       *UserProfile         `bson:"profile,omitempty"`
+	Metadata      dbx.DocumentMetadata `bson:"metadata"`
 }
 
 // Type `UserSession` represents the server-side session details needed to validate refresh tokens and reissue access tokens
@@ -96,13 +180,84 @@ type UserAccount struct {
 //   - NotValidBefore: the timestamp when the session refresh can be used
 //   - NotValidAfter: the timestamp stopping when the session refresh can be used
 //   - Authorizes: the user ID this session authorizes
+//   - Family: the ID shared by every session descended from the same login
 //   - Rotated: indicates whether this session has already been used to rotate access tokens
+//   - CreatedAt: the timestamp of the login the session family started with
+//   - Client: the client the session was last issued to
 type UserSession struct {
 	ID             string        `bson:"_id"`
 	NotValidBefore time.Time     `bson:"not_valid_before"`
 	NotValidAfter  time.Time     `bson:"not_valid_after"`
 	Authorizes     bson.ObjectID `bson:"authorizes"`
+	Family         bson.ObjectID `bson:"family"`
 	Rotated        bool          `bson:"rotated"`
+	CreatedAt      time.Time     `bson:"created_at"`
+	Client         ClientDetails `bson:"client"`
+}
+
+// Type `ClientDetails` represents the identifying details of the client making a request
+//
+// Fields:
+//   - UserAgent: the user agent reported by the client
+//   - IPAddress: the address the request originated from
+type ClientDetails struct {
+	UserAgent string `json:"userAgent" bson:"user_agent"`
+	IPAddress string `json:"ipAddress" bson:"ip_address"`
+}
+
+// Type `SessionFamilyID` represents the URI parameters identifying one of the sessions of the current user
+//
+// Fields:
+//   - ID: the session family ID
+type SessionFamilyID struct {
+	ID string `uri:"sessionid" binding:"required,mongodb"`
+}
+
+// Type `ActiveSession` represents the response structure describing one place a user is logged in
+//
+// Fields:
+//   - ID: the session family ID (used to revoke the session)
+//   - CreatedAt: the timestamp of the login the session started with
+//   - LastRotatedAt: the timestamp the refresh token was last exchanged (omitted if never exchanged)
+//   - ExpiresAt: the timestamp the current refresh token expires
+//   - Client: the client the session was last issued to
+type ActiveSession struct {
+	ID            string        `json:"id"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	LastRotatedAt time.Time     `json:"lastRotatedAt,omitzero"`
+	ExpiresAt     time.Time     `json:"expiresAt"`
+	Client        ClientDetails `json:"client"`
+}
+
+// Type `SessionRevocationResponse` represents the response structure for revoking sessions of the current user
+//
+// Fields:
+//   - Revoked: the number of session records removed
+type SessionRevocationResponse struct {
+	Revoked int64 `json:"revoked"`
+}
+
+// Constants storing the kinds of security events recorded to the security log
+const (
+	SecurityEventRefreshTokenReuse = "REFRESH_TOKEN_REUSE"
+)
+
+// Type `SecurityLogEntry` represents a security relevant event recorded for later review
+//
+// Fields:
+//   - ID: the log entry ID
+//   - Kind: the kind of security event
+//   - User: the user ID the event concerns
+//   - Family: the session family the event concerns (if any)
+//   - Detail: human readable description of the event
+//   - RecordedAt: the timestamp when the event was recorded
+type SecurityLogEntry struct {
+	ID         bson.ObjectID `bson:"_id"`
+	Kind       string        `bson:"kind"`
+	User       bson.ObjectID `bson:"user"`
+	Family     bson.ObjectID `bson:"family,omitzero"`
+	Detail     string        `bson:"detail"`
+	RecordedAt time.Time     `bson:"recorded_at"`
 }
 
 // Type `TokenOptions` groups the information needed to create and verify access tokens
@@ -112,13 +267,19 @@ type UserSession struct {
 //   - Subject: the subject of the access token
 //   - ExpiresIn: duration created access token should remain valid
 //   - Signer: token signing tool
+//   - Key: the shared secret used to verify tokens (HMAC signing only)
+//   - Algorithm: the algorithm tokens are signed with (HMAC signing only)
+//   - Keyset: the public keys used to verify tokens by their `kid` header (nil when signing with a shared secret)
+//   - Revocations: the revocation store consulted when verifying tokens (nil to skip revocation checks)
 type TokenOptions struct {
-	Issuer    string
-	Subject   string
-	ExpiresIn time.Duration
-	Signer    jose.Signer
-	Key       string
-	Algorithm string
+	Issuer      string
+	Subject     string
+	ExpiresIn   time.Duration
+	Signer      jose.Signer
+	Key         string
+	Algorithm   string
+	Keyset      *jose.JSONWebKeySet
+	Revocations TokenRevocationStore
 }
 
 // Type `SessionOptions` groups the information needed to create and verify refresh tokens
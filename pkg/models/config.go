@@ -33,10 +33,16 @@ type ApplicationOptions struct {
 //   - Port: the port to listen on for incoming connections
 //   - Security: option set pertaining to the security setting of the API server process
 //   - Sessions: option set pertaining to the session configuration of the API server authorization process
+//   - Notifications: option set pertaining to how the API server delivers account notifications to users
+//   - LoginThrottle: option set pertaining to how the API server slows down repeated failed logins
+//   - IdentityProviders: the external OpenID Connect providers users can log in with
 type serviceOptions struct {
-	Port     uint            `mapstructure:"port"`
-	Security securityOptions `mapstructure:"security"`
-	Sessions sessionOptions  `mapstructure:"sessions"`
+	Port              uint                      `mapstructure:"port"`
+	Security          securityOptions           `mapstructure:"security"`
+	Sessions          sessionOptions            `mapstructure:"sessions"`
+	Notifications     notificationOptions       `mapstructure:"notifications"`
+	LoginThrottle     loginThrottleOptions      `mapstructure:"loginThrottle"`
+	IdentityProviders []IdentityProviderOptions `mapstructure:"identityProviders"`
 }
 
 // Type `securityOptions` represents the options available to configure security settings for the API server
@@ -45,28 +51,104 @@ type serviceOptions struct {
 //   - TLSEnabled: indicates whether the API server should use TLS or not
 //   - Certificate: /path/to/cert containing the server certificate to use (will be read during configuration unmarshalling)
 //   - Keychain: /path/to/keychain containing the server keycahin to use (will be read during configuration unmarshalling)
+//   - TrustedProxies: the addresses or CIDR ranges of the reverse proxies whose forwarding headers identify the client (none when empty)
 type securityOptions struct {
-	TLSEnabled  bool   `mapstructure:"useTLS"`
-	Certificate string `mapstructure:"certificateFile" fromfile:"required,perm=0400"`
-	Keychain    string `mapstructure:"keychainFile" fromfile:"required,perm=0400"`
+	TLSEnabled     bool     `mapstructure:"useTLS"`
+	Certificate    string   `mapstructure:"certificateFile" fromfile:"required,perm=0400"`
+	Keychain       string   `mapstructure:"keychainFile" fromfile:"required,perm=0400"`
+	TrustedProxies []string `mapstructure:"trustedProxies"`
 }
 
 // Type `sessionOptions` represents the options available to configure how the API server deals with token-based sessions that it issues to clients
 //
 // Members:
-//   - Algorithm: the signing algorithm the API server should use
-//   - SigningKey: the secret key the API server should use to encode and decode access tokens
+//   - Algorithm: the HMAC signing algorithm the API server should use (ignored when signing keys are configured)
+//   - SigningKey: the secret key the API server should use to encode and decode access tokens (ignored when signing keys are configured)
+//   - SigningKeys: the asymmetric key pairs the API server should use to sign and verify access tokens
 //   - AccessTokenTTL: the duration that an access token should remain valid
 //   - RefreshTokenTTL: the duration that a refresh token should remain valid
+//   - PasswordResetTTL: the duration that a password reset token should remain valid
+//   - EmailVerificationTTL: the duration that an email verification token should remain valid
+//   - MFAChallengeTTL: the duration that a login awaiting an authentication code should remain valid
 //   - Issuer: the value to include for the `iss` field of the access token before encoding (will be validated when decoding presented access tokens)
 //   - Subject: the value to include for the `sub` field of the access token before encoding (will be validated when decoding presented access tokens)
 type sessionOptions struct {
-	Algorithm       string        `mapstructure:"signingAlgorithm"`
-	SigningKey      string        `mapstructure:"signingKeyFile" fromfile:"required,perm=0600"`
-	AccessTokenTTL  time.Duration `mapstructure:"accessTokenTTL"`
-	RefreshTokenTTL time.Duration `mapstructure:"refreshTokenTTL"`
-	Issuer          string        `mapstructure:"tokenIssuer"`
-	Subject         string        `mapstructure:"tokenSubject"`
+	Algorithm            string              `mapstructure:"signingAlgorithm"`
+	SigningKey           string              `mapstructure:"signingKeyFile" fromfile:"perm=0600"`
+	SigningKeys          []SigningKeyOptions `mapstructure:"signingKeys"`
+	AccessTokenTTL       time.Duration       `mapstructure:"accessTokenTTL"`
+	RefreshTokenTTL      time.Duration       `mapstructure:"refreshTokenTTL"`
+	PasswordResetTTL     time.Duration       `mapstructure:"passwordResetTTL"`
+	EmailVerificationTTL time.Duration       `mapstructure:"emailVerificationTTL"`
+	MFAChallengeTTL      time.Duration       `mapstructure:"mfaChallengeTTL"`
+	Issuer               string              `mapstructure:"tokenIssuer"`
+	Subject              string              `mapstructure:"tokenSubject"`
+}
+
+// Type `SigningKeyOptions` represents an asymmetric key pair the API server can sign and verify access tokens with
+//
+// Members:
+//   - ID: the key ID placed in the `kid` header of tokens signed with this key
+//   - Algorithm: the signing algorithm to use with this key (RS256, ES256 or EdDSA)
+//   - PrivateKey: /path/to/file containing the PEM encoded private key (will be read during configuration unmarshalling)
+//   - Active: indicates this key signs new tokens (inactive keys are only used to verify tokens issued before a rotation)
+type SigningKeyOptions struct {
+	ID         string `mapstructure:"kid"`
+	Algorithm  string `mapstructure:"algorithm"`
+	PrivateKey string `mapstructure:"privateKeyFile" fromfile:"required,perm=0600"`
+	Active     bool   `mapstructure:"active"`
+}
+
+// Type `IdentityProviderOptions` represents an external OpenID Connect provider users can log in with
+//
+// Members:
+//   - Name: the name identifying the provider in request paths (i.e. google, twitch)
+//   - Issuer: the issuer URL of the provider (its discovery document is served under `/.well-known/openid-configuration`)
+//   - ClientID: the client ID registered with the provider
+//   - ClientSecret: /path/to/file containing the client secret registered with the provider (omitted for public clients, will be read during configuration unmarshalling)
+//   - RedirectURL: the URL the provider returns the user to after authorizing (must match the one registered with the provider)
+//   - Scopes: the scopes to request in addition to `openid` (defaults to `email`)
+type IdentityProviderOptions struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"clientId"`
+	ClientSecret string   `mapstructure:"clientSecretFile" fromfile:"perm=0600"`
+	RedirectURL  string   `mapstructure:"redirectUrl"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// Type `notificationOptions` represents the options available to configure how the API server delivers account notifications
+//
+// Members:
+//   - SMTPHost: the hostname of the mail server to send notifications through (notifications are written to the sink file or log when empty)
+//   - SMTPPort: the port of the mail server
+//   - Sender: the address notifications are sent from
+//   - Username: /path/to/file containing the user to authenticate to the mail server as (will be read during configuration unmarshalling)
+//   - Password: /path/to/file containing the password to authenticate to the mail server with (will be read during configuration unmarshalling)
+//   - SinkFile: /path/to/file notifications are appended to when no mail server is configured (intended for development)
+type notificationOptions struct {
+	SMTPHost string `mapstructure:"smtpHost"`
+	SMTPPort uint   `mapstructure:"smtpPort"`
+	Sender   string `mapstructure:"sender"`
+	Username string `mapstructure:"usernameFile" fromfile:"perm=0600"`
+	Password string `mapstructure:"passwordFile" fromfile:"perm=0600"`
+	SinkFile string `mapstructure:"sinkFile"`
+}
+
+// Type `loginThrottleOptions` represents the options available to configure how the API server responds to repeated failed logins
+//
+// Members:
+//   - AccountFailures: the consecutive failures against one account before it is temporarily locked
+//   - ClientFailures: the consecutive failures from one client address before it is temporarily locked
+//   - Lockout: the duration of the first lockout (doubled with each further failure)
+//   - MaxLockout: the longest duration a single lockout may last
+//   - Window: the duration without failures after which recorded failures are forgotten
+type loginThrottleOptions struct {
+	AccountFailures int           `mapstructure:"accountFailures"`
+	ClientFailures  int           `mapstructure:"clientFailures"`
+	Lockout         time.Duration `mapstructure:"lockout"`
+	MaxLockout      time.Duration `mapstructure:"maxLockout"`
+	Window          time.Duration `mapstructure:"window"`
 }
 
 // Type `recordStorageOptions` represents the options available to configure how the API server stores structured records
@@ -87,10 +169,16 @@ type recordStorageOptions struct {
 //   - Endpoint: the location of the object storage solution
 //   - AccessKey: /path/to/file containing the key used to claim access to the object storage service (will be read during configuration unmarshalling)
 //   - SecretKey: /path/to/file containing the key used to response to authentication challenges from the object storage service (will be read during configuration unmarshalling)
+//   - ExportBucket: the bucket personal data exports are stored in (created when missing)
+//   - ExportTTL: duration a requested personal data export remains available for download
+//   - ExportLinkTTL: duration a pre-signed personal data export download link remains valid
 type objectStorageOptions struct {
-	Endpoint  string `mapstructure:"endpoint"`
-	AccessKey string `mapstructure:"accessKey" fromfile:"required,perm=0600"`
-	SecretKey string `mapstructure:"secretKey" fromfile:"required,perm=0600"`
+	Endpoint      string        `mapstructure:"endpoint"`
+	AccessKey     string        `mapstructure:"accessKey" fromfile:"required,perm=0600"`
+	SecretKey     string        `mapstructure:"secretKey" fromfile:"required,perm=0600"`
+	ExportBucket  string        `mapstructure:"exportBucket"`
+	ExportTTL     time.Duration `mapstructure:"exportTTL"`
+	ExportLinkTTL time.Duration `mapstructure:"exportLinkTTL"`
 }
 
 // Type `loggingOptions` represents the structured logging options component of the configuration file structure
@@ -12,6 +12,8 @@ package models
  */
 
 import (
+	"time"
+
 	"github.com/tournabyte/webapi/pkg/dbx"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
@@ -28,9 +30,70 @@ const (
 	StatusPlanned                    = "PLANNED"
 	StatusInProgress                 = "IN-PROGRESS"
 	StatusConcluded                  = "CONCLUDED"
+	StatusCancelled                  = "CANCELLED"
 	ParticipantFieldReferencesMatch  = "MATCH"
 	ParticipantFieldReferencesPlayer = "PARTICIPANT"
 	ParticipantFieldReferencesBye    = "BYE"
+	ParticipantFieldReferencesLoser  = "LOSER"
+)
+
+// Constants storing the competition formats a match set can be generated with
+const (
+	FormatSingleElimination = "SINGLE-ELIMINATION"
+	FormatDoubleElimination = "DOUBLE-ELIMINATION"
+	FormatRoundRobin        = "ROUND-ROBIN"
+	FormatSwiss             = "SWISS"
+)
+
+// Constants storing the bracket labels a match can be associated with
+const (
+	BracketWinners         = "WINNERS"
+	BracketLosers          = "LOSERS"
+	BracketGrandFinal      = "GRAND-FINAL"
+	BracketGrandFinalReset = "GRAND-FINAL-RESET"
+	BracketRoundRobin      = "ROUND-ROBIN"
+	BracketSwiss           = "SWISS"
+)
+
+// Constants storing the roles a user can hold on an event's staff
+const (
+	RoleOwner       = "OWNER"
+	RoleOrganizer   = "ORGANIZER"
+	RoleScorekeeper = "SCOREKEEPER"
+	RoleViewer      = "VIEWER"
+)
+
+// Constants storing the ways an event can be removed
+const (
+	RemovalModeDelete  = "delete"
+	RemovalModeArchive = "archive"
+)
+
+// Constants storing the orderings an event listing can be sorted by (a leading `-` sorts in descending order)
+const (
+	SortByName          = "name"
+	SortByNameDesc      = "-name"
+	SortByCreatedAt     = "created"
+	SortByCreatedAtDesc = "-created"
+)
+
+// Constants storing the page size bounds of an event listing
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Constants storing the ways participants can be ordered before they are placed into a match set
+const (
+	SeedingManual = "MANUAL"
+	SeedingRandom = "RANDOM"
+)
+
+// Constants storing the points awarded for match results when calculating standings
+const (
+	PointsForWin  = 3
+	PointsForDraw = 1
+	PointsForLoss = 0
 )
 
 // Type `CreateEventRequest` represents the request body format for the create event endpoint
@@ -39,10 +102,12 @@ const (
 //   - Name: the name of the event (to show instead of an ID)
 //   - Game: the game the event is focused around
 //   - Description: the description of the event
+//   - OpenRegistration: whether users may register themselves as participants
 type CreateEventRequest struct {
-	Name        string `json:"name" binding:"required,min=4,max=128"`
-	Game        string `json:"game" binding:"required,min=4,max=128"`
-	Description string `json:"description" binding:"max=1024"`
+	Name             string `json:"name" binding:"required,min=4,max=128"`
+	Game             string `json:"game" binding:"required,min=4,max=128"`
+	Description      string `json:"description" binding:"max=1024"`
+	OpenRegistration bool   `json:"openRegistration"`
 }
 
 // Type `UpdateEventRequest` represents the request body format for the update event endpoint
@@ -51,12 +116,12 @@ type CreateEventRequest struct {
 //   - NewName: the new name of the event
 //   - NewGame: the new game of the event
 //   - NewDescription: the new description of the event
-//   - NewStatus: the new status of the event
+//   - OpenRegistration: whether users may register themselves as participants (omitted to leave unchanged)
 type UpdateEventRequest struct {
-	NewName        string `json:"name" binding:"max=128"`
-	NewGame        string `json:"game" binding:"max=128"`
-	NewDescription string `json:"description" binding:"max=1024"`
-	NewStatus      string `json:"status" binding:"max=128"`
+	NewName          string `json:"name" binding:"max=128"`
+	NewGame          string `json:"game" binding:"max=128"`
+	NewDescription   string `json:"description" binding:"max=1024"`
+	OpenRegistration *bool  `json:"openRegistration"`
 }
 
 // Type `EventID` represents a response to an successful event (created/updated/deleted) endpoint usage
@@ -67,30 +132,144 @@ type EventID struct {
 	ID string `json:"id" uri:"eventid" binding:"required,mongodb"`
 }
 
+// Type `ListEventsRequest` represents the request query parameters for the list events endpoint
+//
+// Fields:
+//   - Host: only include events created by this user ID
+//   - Game: only include events focused around this game
+//   - Status: only include events with this status
+//   - Search: only include events whose name contains this text (case-insensitive)
+//   - CreatedAfter: only include events created at or after this time
+//   - CreatedBefore: only include events created before this time
+//   - Sort: the ordering of the listing (defaults to newest first)
+//   - Limit: the maximum number of events per page (defaults to `DefaultListLimit`)
+//   - Cursor: the opaque position of the page to fetch (from a previous response, omitted for the first page)
+type ListEventsRequest struct {
+	Host          string    `form:"host" binding:"omitempty,mongodb"`
+	Game          string    `form:"game" binding:"max=128"`
+	Status        string    `form:"status" binding:"omitempty,oneof=PLANNED IN-PROGRESS CONCLUDED CANCELLED"`
+	Search        string    `form:"q" binding:"max=128"`
+	CreatedAfter  time.Time `form:"createdAfter"`
+	CreatedBefore time.Time `form:"createdBefore"`
+	Sort          string    `form:"sort" binding:"omitempty,oneof=name -name created -created"`
+	Limit         int64     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor        string    `form:"cursor" binding:"omitempty,base64url"`
+}
+
+// Type `EventListResponse` represents a page of an event listing
+//
+// Fields:
+//   - Events: the events on this page
+//   - Next: the cursor for the following page (omitted on the last page)
+type EventListResponse struct {
+	Events []EventRecord `json:"events"`
+	Next   string        `json:"next,omitempty"`
+}
+
+// Type `RemoveEventRequest` represents the request query parameters for the delete event endpoint
+//
+// Fields:
+//   - Mode: whether the event and its dependent records are deleted or kept as inactive records (defaults to delete)
+type RemoveEventRequest struct {
+	Mode string `form:"mode" binding:"omitempty,oneof=delete archive"`
+}
+
+// Type `EventRemovalResponse` represents a response to a successful event removal
+//
+// Fields:
+//   - ID: the event deleted/archived
+//   - Mode: whether the records were deleted or archived
+//   - Participants: the number of participant records deleted/archived with the event
+//   - Matches: the number of match records deleted/archived with the event
+type EventRemovalResponse struct {
+	ID           string `json:"id"`
+	Mode         string `json:"mode"`
+	Participants int64  `json:"participants"`
+	Matches      int64  `json:"matches"`
+}
+
 // Type `EventRecord` represents a database record for an event
 //
 // Fields:
 //   - ID: the unique ID for this event
 //   - Host: the user ID that created this event
-//   - Status: the status of this event (i.e. planned, in-progress, concluded)
+//   - Status: the status of this event (i.e. planned, in-progress, concluded, cancelled)
 //   - Name: the name of the event
 //   - Game: the game the event is focused around
 //   - Description: the description of the event
+//   - DrawSeed: the random number generator seed used to order unseeded participants (zero when the match set was seeded manually)
+//   - OpenRegistration: whether users may register themselves as participants
+//   - Staff: the users (other than the host) helping to run this event and their roles (only listed to staff through the staff endpoint)
+//   - Metadata: event document metadata
 type EventRecord struct {
-	ID          bson.ObjectID `json:"id" bson:"_id"`
-	Host        bson.ObjectID `json:"hostedBy" bson:"host"`
-	Status      string        `json:"status" bson:"status"`
-	Name        string        `json:"name" bson:"name"`
-	Game        string        `json:"game" bson:"game"`
-	Description string        `json:"description" bson:"description"`
+	ID               bson.ObjectID        `json:"id" bson:"_id"`
+	Host             bson.ObjectID        `json:"hostedBy" bson:"host"`
+	Status           string               `json:"status" bson:"status"`
+	Name             string               `json:"name" bson:"name"`
+	Game             string               `json:"game" bson:"game"`
+	Description      string               `json:"description" bson:"description"`
+	DrawSeed         int64                `json:"drawSeed,omitempty" bson:"draw_seed,omitempty"`
+	OpenRegistration bool                 `json:"openRegistration" bson:"open_registration,omitempty"`
+	Staff            []EventStaffMember   `json:"-" bson:"staff,omitempty"`
+	Metadata         dbx.DocumentMetadata `json:"-" bson:"metadata"`
+}
+
+// Type `EventStaffMember` represents a user helping to run an event
+//
+// Fields:
+//   - User: the user ID of the staff member
+//   - Role: the role of the staff member (i.e. organizer, scorekeeper, viewer)
+type EventStaffMember struct {
+	User bson.ObjectID `json:"user" bson:"user"`
+	Role string        `json:"role" bson:"role"`
+}
+
+// Type `InviteStaffRequest` represents the request body for adding a user to an event's staff
+//
+// Fields:
+//   - User: the user ID to add to the staff
+//   - Role: the role to give the user
+type InviteStaffRequest struct {
+	User string `json:"user" binding:"required,mongodb"`
+	Role string `json:"role" binding:"required,oneof=ORGANIZER SCOREKEEPER VIEWER"`
+}
+
+// Type `ChangeStaffRoleRequest` represents the request body for changing the role of an event staff member
+//
+// Fields:
+//   - Role: the new role of the staff member
+type ChangeStaffRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=ORGANIZER SCOREKEEPER VIEWER"`
+}
+
+// Type `StaffMemberID` represents the request URI for looking up an event staff member
+//
+// Fields:
+//   - EID: event ID the staff member helps to run
+//   - UID: user ID of the staff member
+type StaffMemberID struct {
+	EID string `uri:"eventid" binding:"required,mongodb" json:"eventid"`
+	UID string `uri:"userid" binding:"required,mongodb" json:"userid"`
 }
 
 // Type `CreateOrModifyParticipantRequest` represents the request body for a new participant
 //
 // Fields:
 //   - DisplayName: the name to use for the participant's display name
+//   - Seed: the seed of the participant (1 is the top seed, omitted for unseeded participants)
+//   - User: the ID of the user whose profile the participant represents (omitted for participants without an account)
 type CreateOrModifyParticipantRequest struct {
 	DisplayName string `json:"name" binding:"required,min=4,max=64"`
+	Seed        int    `json:"seed" binding:"omitempty,min=1,max=256"`
+	User        string `json:"user" binding:"omitempty,mongodb"`
+}
+
+// Type `RegistrationRequest` represents the request body for the current user registering as a participant of an event
+//
+// Fields:
+//   - DisplayName: the name to use for the participant's display name (defaults to the display name of the user's profile)
+type RegistrationRequest struct {
+	DisplayName string `json:"name" binding:"omitempty,min=4,max=64"`
 }
 
 // Type `ParticipantLookupRequest` represents the request URI for looking up a participant
@@ -108,11 +287,17 @@ type ParticipantID struct {
 // Fields:
 //   - ID: the unique ID of the participant
 //   - DisplayName: the name shown in the UI for this participant
+//   - Seed: the seed of the participant used for bracket placement (zero when unseeded)
+//   - User: references the ID of the user whose profile the participant represents (zero for participants without an account)
 //   - ParticipatesIn: references the ID of the event this participant takes part in
+//   - Metadata: participant document metadata
 type EventParticipant struct {
-	ID             bson.ObjectID `json:"id" bson:"_id"`
-	DisplayName    string        `json:"displayName" bson:"display_name"`
-	ParticipatesIn bson.ObjectID `json:"participatesIn" bson:"participates_in"`
+	ID             bson.ObjectID        `json:"id" bson:"_id"`
+	DisplayName    string               `json:"displayName" bson:"display_name"`
+	Seed           int                  `json:"seed,omitempty" bson:"seed,omitempty"`
+	User           bson.ObjectID        `json:"user,omitzero" bson:"user,omitempty"`
+	ParticipatesIn bson.ObjectID        `json:"participatesIn" bson:"participates_in"`
+	Metadata       dbx.DocumentMetadata `json:"-" bson:"metadata"`
 }
 
 // Type `EventMatch` represents a match record associated with an event
@@ -123,16 +308,68 @@ type EventParticipant struct {
 //   - AwayRef: states whether `AwayParticipant` refers to a participant ID or a match ID
 //   - HomeParticipant: the ID of the home participant or the match it is sourced from
 //   - HomeRef: states whether `HomeParticipant` refers to a participant ID or a match ID
+//   - AwaySource: the match the away participant is sourced from (kept once the participant is known so results can be rolled back)
+//   - AwaySourceRef: states whether the away participant is the winner or the loser of `AwaySource`
+//   - HomeSource: the match the home participant is sourced from (kept once the participant is known so results can be rolled back)
+//   - HomeSourceRef: states whether the home participant is the winner or the loser of `HomeSource`
 //   - Winner: the declared winner of the match (used by match referencing this match to populate participants)
+//   - Loser: the participant eliminated by the declared winner (used by losers bracket matches referencing this match)
+//   - Draw: whether the match ended without a winner (only possible for round-robin and swiss matches)
+//   - Bracket: the bracket this match belongs to (empty for single-elimination match sets)
+//   - Round: the round of its bracket this match is played in (starting at 1)
+//   - Group: the round-robin group this match belongs to (starting at 1, zero when the event has no groups)
+//   - BestOf: the maximum number of games in the series (zero is treated as a single game)
+//   - Games: the game-by-game scores recorded for the series
 //   - TakesPlaceDuring: references the ObjectID of the event this match is associated with
+//   - Metadata: match document metadata
 type EventMatch struct {
-	ID               bson.ObjectID `json:"id" bson:"_id"`
-	AwayParticipant  bson.ObjectID `json:"away" bson:"away"`
-	AwayRef          string        `json:"-" bson:"away_ref"`
-	HomeParticipant  bson.ObjectID `json:"home" bson:"home"`
-	HomeRef          string        `json:"-" bson:"home_ref"`
-	Winner           bson.ObjectID `json:"winner,omitempty" bson:"winner,omitempty"`
-	TakesPlaceDuring bson.ObjectID `json:"takesPlaceDuring" bson:"takes_place_during"`
+	ID               bson.ObjectID        `json:"id" bson:"_id"`
+	AwayParticipant  bson.ObjectID        `json:"away" bson:"away"`
+	AwayRef          string               `json:"-" bson:"away_ref"`
+	HomeParticipant  bson.ObjectID        `json:"home" bson:"home"`
+	HomeRef          string               `json:"-" bson:"home_ref"`
+	AwaySource       bson.ObjectID        `json:"-" bson:"away_source,omitempty"`
+	AwaySourceRef    string               `json:"-" bson:"away_source_ref,omitempty"`
+	HomeSource       bson.ObjectID        `json:"-" bson:"home_source,omitempty"`
+	HomeSourceRef    string               `json:"-" bson:"home_source_ref,omitempty"`
+	Winner           bson.ObjectID        `json:"winner,omitempty" bson:"winner,omitempty"`
+	Loser            bson.ObjectID        `json:"loser,omitempty" bson:"loser,omitempty"`
+	Draw             bool                 `json:"draw,omitempty" bson:"draw,omitempty"`
+	Bracket          string               `json:"bracket,omitempty" bson:"bracket,omitempty"`
+	Round            int                  `json:"round,omitempty" bson:"round,omitempty"`
+	Group            int                  `json:"group,omitempty" bson:"group,omitempty"`
+	BestOf           int                  `json:"bestOf,omitempty" bson:"best_of,omitempty"`
+	Games            []MatchGame          `json:"games,omitempty" bson:"games,omitempty"`
+	TakesPlaceDuring bson.ObjectID        `json:"takesPlaceDuring" bson:"takes_place_during"`
+	Metadata         dbx.DocumentMetadata `json:"-" bson:"metadata"`
+}
+
+// Type `MatchGame` represents the score of a single game within a match series
+//
+// Fields:
+//   - Home: the score of the home participant
+//   - Away: the score of the away participant
+type MatchGame struct {
+	Home int `json:"home" bson:"home" binding:"min=0"`
+	Away int `json:"away" bson:"away" binding:"min=0"`
+}
+
+// Type `CreateMatchSetRequest` represents the request body for generating the match set of an event
+//
+// Fields:
+//   - Format: the competition format to generate matches for (defaults to single-elimination; swiss only pairs the first round)
+//   - BracketReset: whether a double-elimination grand final is followed by a reset match when the losers bracket champion wins
+//   - Groups: the number of groups to split participants into for round-robin play (defaults to a single group)
+//   - Seeding: how unseeded participants are ordered behind the seeded ones (defaults to manual, which keeps registration order)
+//   - RandomSeed: the random number generator seed to shuffle unseeded participants with (generated when omitted for random seeding)
+//   - BestOf: the number of games every match is played over (must be odd, defaults to a single game)
+type CreateMatchSetRequest struct {
+	Format       string `json:"format" binding:"omitempty,oneof=SINGLE-ELIMINATION DOUBLE-ELIMINATION ROUND-ROBIN SWISS"`
+	BracketReset bool   `json:"bracketReset"`
+	Groups       int    `json:"groups" binding:"omitempty,min=1,max=64"`
+	Seeding      string `json:"seeding" binding:"omitempty,oneof=MANUAL RANDOM"`
+	RandomSeed   int64  `json:"randomSeed" binding:"omitempty,min=1,excluded_unless=Seeding RANDOM"`
+	BestOf       int    `json:"bestOf" binding:"omitempty,min=1,max=99"`
 }
 
 // Type `MatchID` represents the request URI for looking up a match
@@ -149,6 +386,58 @@ type MatchID struct {
 //
 // Fields:
 //   - DeclareWinner: the winner being declared
+//   - DeclareDraw: declares the match a draw instead of declaring a winner
 type DeclarMatchWinnerRequest struct {
-	DeclareWinner string `json:"declareWinner" binding:"required,mongodb"`
+	DeclareWinner string `json:"declareWinner" binding:"required_without=DeclareDraw,omitempty,mongodb"`
+	DeclareDraw   bool   `json:"declareDraw" binding:"excluded_with=DeclareWinner"`
+}
+
+// Type `SubmitMatchScoreRequest` represents the request body for recording the score of a match series
+//
+// Fields:
+//   - BestOf: overrides the number of games the match is played over (must be odd)
+//   - Games: the game-by-game scores played so far (replaces any previously recorded scores)
+type SubmitMatchScoreRequest struct {
+	BestOf int         `json:"bestOf" binding:"omitempty,min=1,max=99"`
+	Games  []MatchGame `json:"games" binding:"required,max=99,dive"`
+}
+
+// Type `MatchRevisionResponse` represents a response to a successful match result revision
+//
+// Fields:
+//   - Match: the match whose result was revised
+//   - Invalidated: the downstream matches whose participants and results were reset by the revision
+type MatchRevisionResponse struct {
+	Match       string   `json:"match"`
+	Invalidated []string `json:"invalidated"`
+}
+
+// Type `EventStanding` represents a participant's position in the standings of an event
+//
+// Fields:
+//   - Rank: the position of the participant within its group (starting at 1)
+//   - Group: the round-robin group the participant competes in (zero when the event has no groups)
+//   - Participant: the ID of the participant
+//   - DisplayName: the name shown in the UI for the participant
+//   - Played: the number of decided matches the participant took part in
+//   - Wins: the number of matches the participant won
+//   - Losses: the number of matches the participant lost
+//   - Draws: the number of matches the participant drew
+//   - Points: the points earned from match results
+//   - HeadToHead: the points earned against participants tied on points (first tiebreaker)
+//   - Buchholz: the sum of the points earned by every opponent faced (second tiebreaker)
+//   - OMWP: the average match win percentage of every opponent faced, each floored at one third (third tiebreaker)
+type EventStanding struct {
+	Rank        int           `json:"rank"`
+	Group       int           `json:"group,omitempty"`
+	Participant bson.ObjectID `json:"participant"`
+	DisplayName string        `json:"displayName"`
+	Played      int           `json:"played"`
+	Wins        int           `json:"wins"`
+	Losses      int           `json:"losses"`
+	Draws       int           `json:"draws"`
+	Points      int           `json:"points"`
+	HeadToHead  int           `json:"headToHead"`
+	Buchholz    int           `json:"buchholz"`
+	OMWP        float64       `json:"opponentMatchWinPercentage"`
 }
@@ -0,0 +1,114 @@
+package models
+
+/*
+ * File: pkg/models/mfa.go
+ *
+ * Purpose: data models for TOTP based two-factor authentication
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Variables storing query context associated with two-factor authentication operations
+var (
+	MFAChallengeQueryContext = dbx.NewQueryContext(`tournabyte`, `mfa_challenges`)
+)
+
+// Type `AccountMFA` represents the two-factor authentication settings stored with an account
+//
+// Fields:
+//   - Enabled: indicates logins must be completed with an authentication code
+//   - Secret: the base32 encoded TOTP secret of the confirmed authenticator
+//   - PendingSecret: the base32 encoded TOTP secret of an authenticator awaiting confirmation
+//   - RecoveryCodes: the hashes of the unused single-use recovery codes
+//   - LastStep: the TOTP time step of the last accepted code (codes of this step or earlier are refused)
+//   - EnabledAt: the timestamp the authenticator was confirmed
+type AccountMFA struct {
+	Enabled       bool      `bson:"enabled"`
+	Secret        string    `bson:"secret,omitempty"`
+	PendingSecret string    `bson:"pending_secret,omitempty"`
+	RecoveryCodes []string  `bson:"recovery_codes,omitempty"`
+	LastStep      int64     `bson:"last_step"`
+	EnabledAt     time.Time `bson:"enabled_at"`
+}
+
+// Type `MFAEnrollment` represents the response body for starting two-factor authentication enrollment
+//
+// Fields:
+//   - Secret: the base32 encoded TOTP secret to register with an authenticator app
+//   - URI: the `otpauth://` URI encoding the secret (typically rendered as a QR code)
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// Type `MFACodeRequest` represents the request body presenting an authentication code for the current user
+//
+// Fields:
+//   - Code: the TOTP code (or a recovery code where accepted)
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// Type `MFARecoveryCodes` represents the response body for a confirmed two-factor authentication enrollment
+//
+// Fields:
+//   - RecoveryCodes: the single-use recovery codes (only ever shown once)
+type MFARecoveryCodes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// Type `MFAChallengeResponse` represents the response body for a login that must be completed with an authentication code
+//
+// Fields:
+//   - ID: the user ID of the account logging in
+//   - MFARequired: always true (distinguishes the response from `AuthenticatedUser`)
+//   - ChallengeToken: the token to present along with the authentication code
+//   - ExpiresAt: the timestamp the challenge token expires
+type MFAChallengeResponse struct {
+	ID             string    `json:"id"`
+	MFARequired    bool      `json:"mfaRequired"`
+	ChallengeToken string    `json:"challengeToken"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// Type `MFAVerificationRequest` represents the request body for completing a login with an authentication code
+//
+// Fields:
+//   - ChallengeToken: the challenge token issued by the login
+//   - Code: the TOTP code or a recovery code
+type MFAVerificationRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+// Type `MFAChallenge` represents a stored login awaiting an authentication code
+//
+// Fields:
+//   - ID: the hash of the challenge token delivered to the client (the token itself is never stored)
+//   - Authorizes: the user ID the challenge completes the login of
+//   - NotValidAfter: the timestamp the challenge expires (the record is removed afterwards)
+//   - CreatedAt: the timestamp the challenge was issued
+type MFAChallenge struct {
+	ID            string        `bson:"_id"`
+	Authorizes    bson.ObjectID `bson:"authorizes"`
+	NotValidAfter time.Time     `bson:"not_valid_after"`
+	CreatedAt     time.Time     `bson:"created_at"`
+}
+
+// Type `MFAOptions` groups the information needed to challenge logins of accounts with two-factor authentication
+//
+// Fields:
+//   - ChallengeExpiresIn: duration issued challenge tokens should remain valid
+type MFAOptions struct {
+	ChallengeExpiresIn time.Duration
+}
@@ -0,0 +1,85 @@
+package models
+
+/*
+ * File: pkg/models/oidc.go
+ *
+ * Purpose: data models for logging in through external OpenID Connect providers
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+)
+
+// Variables storing query context associated with external login operations
+var (
+	OIDCLoginQueryContext = dbx.NewQueryContext(`tournabyte`, `oidc_logins`)
+)
+
+// Type `ExternalIdentity` represents an identity at an external provider linked to a user account
+//
+// Fields:
+//   - Provider: the name of the provider the identity belongs to
+//   - Subject: the identifier of the user at the provider (the `sub` claim)
+//   - Email: the email the provider reported for the user when the identity was linked
+//   - LinkedAt: the timestamp the identity was linked
+type ExternalIdentity struct {
+	Provider string    `json:"provider" bson:"provider"`
+	Subject  string    `json:"subject" bson:"subject"`
+	Email    string    `json:"email" bson:"email"`
+	LinkedAt time.Time `json:"linkedAt" bson:"linked_at"`
+}
+
+// Type `IdentityProviderLookup` represents the request URI selecting an external provider
+//
+// Fields:
+//   - Provider: the name of the provider
+type IdentityProviderLookup struct {
+	Provider string `uri:"provider" binding:"required"`
+}
+
+// Type `OIDCAuthorization` represents the response body for starting a login through an external provider
+//
+// Fields:
+//   - AuthorizationURL: the URL to send the user to for authorizing the login
+//   - State: the value the provider returns along with the authorization code
+//   - ExpiresAt: the timestamp the login must be completed by
+type OIDCAuthorization struct {
+	AuthorizationURL string    `json:"authorizationUrl"`
+	State            string    `json:"state"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+}
+
+// Type `OIDCCallbackRequest` represents the request body for completing a login through an external provider
+//
+// Fields:
+//   - State: the state returned by the provider
+//   - Code: the authorization code returned by the provider
+type OIDCCallbackRequest struct {
+	State string `json:"state" binding:"required"`
+	Code  string `json:"code" binding:"required"`
+}
+
+// Type `OIDCLogin` represents a stored login through an external provider awaiting its authorization code
+//
+// Fields:
+//   - ID: the hash of the state delivered to the client (the state itself is never stored)
+//   - Provider: the name of the provider the login was started with
+//   - Nonce: the value the ID token issued for the login must contain
+//   - Verifier: the PKCE code verifier proving the authorization code was requested by this server
+//   - NotValidAfter: the timestamp the login expires (the record is removed afterwards)
+//   - CreatedAt: the timestamp the login was started
+type OIDCLogin struct {
+	ID            string    `bson:"_id"`
+	Provider      string    `bson:"provider"`
+	Nonce         string    `bson:"nonce"`
+	Verifier      string    `bson:"verifier"`
+	NotValidAfter time.Time `bson:"not_valid_after"`
+	CreatedAt     time.Time `bson:"created_at"`
+}
@@ -0,0 +1,77 @@
+package models
+
+/*
+ * File: pkg/models/password.go
+ *
+ * Purpose: data models for password changes and password resets
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/notify"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Variables storing query context associated with password reset operations
+var (
+	PasswordResetQueryContext = dbx.NewQueryContext(`tournabyte`, `password_resets`)
+)
+
+// Type `PasswordChangeRequest` represents the request body for changing the password of the current user
+//
+// Fields:
+//   - CurrentPassword: the password the account currently has (verified before the change is applied)
+//   - NewPassword: the password to replace it with
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required,min=8"`
+}
+
+// Type `PasswordResetRequest` represents the request body for requesting a password reset token
+//
+// Fields:
+//   - Email: the login email of the account to reset the password of
+type PasswordResetRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// Type `PasswordResetRedemption` represents the request body for exchanging a password reset token for a new password
+//
+// Fields:
+//   - Token: the reset token delivered to the user
+//   - NewPassword: the password to replace the current one with
+type PasswordResetRedemption struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"newPassword" binding:"required,min=8"`
+}
+
+// Type `PasswordResetToken` represents a stored password reset token
+//
+// Fields:
+//   - ID: the hash of the token delivered to the user (the token itself is never stored)
+//   - Authorizes: the user ID whose password the token can reset
+//   - NotValidAfter: the timestamp the token expires (the record is removed afterwards)
+//   - CreatedAt: the timestamp the token was issued
+type PasswordResetToken struct {
+	ID            string        `bson:"_id"`
+	Authorizes    bson.ObjectID `bson:"authorizes"`
+	NotValidAfter time.Time     `bson:"not_valid_after"`
+	CreatedAt     time.Time     `bson:"created_at"`
+}
+
+// Type `PasswordResetOptions` groups the information needed to issue and deliver password reset tokens
+//
+// Fields:
+//   - ExpiresIn: duration created reset tokens should remain valid
+//   - Notifier: the delivery channel of reset tokens
+type PasswordResetOptions struct {
+	ExpiresIn time.Duration
+	Notifier  notify.Notifier
+}
@@ -0,0 +1,140 @@
+package models
+
+/*
+ * File: pkg/models/privacy.go
+ *
+ * Purpose: data models for personal data exports and account deletion
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Variables storing query context associated with personal data export operations
+var (
+	DataExportQueryContext = dbx.NewQueryContext(`tournabyte`, `data_exports`)
+)
+
+// Constants storing the states a personal data export moves through
+const (
+	DataExportPending = "PENDING"
+	DataExportReady   = "READY"
+	DataExportFailed  = "FAILED"
+)
+
+// Type `DataExport` represents a requested archive of the personal data of a user
+//
+// Fields:
+//   - ID: the export ID
+//   - Owner: the user ID whose data is exported
+//   - Status: the state of the export (pending, ready or failed)
+//   - Object: the name of the archive within the export bucket
+//   - RequestedAt: the timestamp the export was requested
+//   - CompletedAt: the timestamp the archive was stored or generating it failed (nil while pending)
+//   - NotValidAfter: the timestamp the export can no longer be downloaded (the record is removed afterwards)
+type DataExport struct {
+	ID            bson.ObjectID `json:"id" bson:"_id"`
+	Owner         bson.ObjectID `json:"-" bson:"owner"`
+	Status        string        `json:"status" bson:"status"`
+	Object        string        `json:"-" bson:"object"`
+	RequestedAt   time.Time     `json:"requestedAt" bson:"requested_at"`
+	CompletedAt   *time.Time    `json:"completedAt,omitempty" bson:"completed_at,omitempty"`
+	NotValidAfter time.Time     `json:"expiresAt" bson:"not_valid_after"`
+}
+
+// Type `DataExportLookupRequest` represents the request URI selecting one of the personal data exports of the current user
+//
+// Fields:
+//   - ID: the export ID
+type DataExportLookupRequest struct {
+	ID string `uri:"exportid" binding:"required,mongodb"`
+}
+
+// Type `DataExportStatus` represents the response body describing a personal data export
+//
+// Fields:
+//   - DataExport: the export record
+//   - DownloadURL: the pre-signed link downloading the archive (omitted unless the export is ready)
+//   - DownloadExpiresAt: the timestamp the download link stops working (omitted unless the export is ready)
+type DataExportStatus struct {
+	DataExport
+	DownloadURL       string     `json:"downloadUrl,omitempty"`
+	DownloadExpiresAt *time.Time `json:"downloadExpiresAt,omitempty"`
+}
+
+// Type `PersonalDataArchive` represents the contents of a personal data export
+//
+// Fields:
+//   - GeneratedAt: the timestamp the archive was generated
+//   - Account: the account and profile of the user
+//   - Identities: the external identities linked to the account
+//   - Sessions: the places the user is logged in
+//   - APIKeys: the API keys issued to the user
+//   - HostedEvents: the events the user hosts (including archived events)
+//   - Participations: the participants referencing the user
+//   - Matches: the matches played by those participants
+type PersonalDataArchive struct {
+	GeneratedAt    time.Time          `json:"generatedAt"`
+	Account        OwnProfile         `json:"account"`
+	Identities     []ExternalIdentity `json:"identities"`
+	Sessions       []ActiveSession    `json:"sessions"`
+	APIKeys        []APIKeyRecord     `json:"apiKeys"`
+	HostedEvents   []EventRecord      `json:"hostedEvents"`
+	Participations []EventParticipant `json:"participations"`
+	Matches        []EventMatch       `json:"matches"`
+}
+
+// Type `DataExporter` represents the background generation and storage of personal data archives
+type DataExporter interface {
+	// Schedule starts generating the archive of the given export in the background (the context holds the database session of the request)
+	Schedule(ctx context.Context, export DataExport) error
+	// DownloadURL creates a pre-signed link downloading the archive of the given export, valid until the returned timestamp
+	DownloadURL(ctx context.Context, export DataExport) (string, time.Time, error)
+	// Discard removes every stored archive of the given user
+	Discard(ctx context.Context, owner bson.ObjectID) error
+}
+
+// Type `DataExportOptions` groups the information needed to request and download personal data exports
+//
+// Fields:
+//   - ExpiresIn: duration a requested export remains available for download
+//   - Exporter: the background generator and storage of archives
+type DataExportOptions struct {
+	ExpiresIn time.Duration
+	Exporter  DataExporter
+}
+
+// Type `AccountDeletionRequest` represents the request body for deleting the account of the current user
+//
+// Fields:
+//   - Password: the password of the account (required unless the account logs in through an identity provider only)
+//   - TransferTo: the user ID to hand hosted events over to (omitted to archive hosted events instead)
+type AccountDeletionRequest struct {
+	Password   string `json:"password"`
+	TransferTo string `json:"transferTo" binding:"omitempty,mongodb"`
+}
+
+// Type `AccountDeletionResponse` represents the response body for deleting the account of the current user
+//
+// Fields:
+//   - ID: the deleted user account ID
+//   - SessionsClosed: the number of sessions of the account closed
+//   - EventsTransferred: the number of hosted events handed over to another user
+//   - EventsArchived: the number of hosted events archived
+//   - ParticipantsAnonymised: the number of participants no longer referencing the account
+type AccountDeletionResponse struct {
+	ID                     string `json:"id"`
+	SessionsClosed         int64  `json:"sessionsClosed"`
+	EventsTransferred      int64  `json:"eventsTransferred"`
+	EventsArchived         int64  `json:"eventsArchived"`
+	ParticipantsAnonymised int64  `json:"participantsAnonymised"`
+}
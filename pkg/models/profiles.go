@@ -0,0 +1,88 @@
+package models
+
+/*
+ * File: pkg/models/profiles.go
+ *
+ * Purpose: data models for user profiles and their public projection
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import "time"
+
+// Type `GameHandle` represents the name a user goes by within a particular game
+//
+// Fields:
+//   - Game: the game the handle belongs to
+//   - Handle: the name of the user within the game
+type GameHandle struct {
+	Game   string `json:"game" bson:"game" binding:"required,max=32"`
+	Handle string `json:"handle" bson:"handle" binding:"required,max=64"`
+}
+
+// Type `UserProfile` represents the self-described details of a user shown to other users
+//
+// Fields:
+//   - DisplayName: the name shown for the user
+//   - AvatarURL: the location of the picture shown for the user
+//   - Bio: a short description of the user
+//   - GameHandles: the names the user goes by within games
+type UserProfile struct {
+	DisplayName string       `bson:"display_name,omitempty"`
+	AvatarURL   string       `bson:"avatar_url,omitempty"`
+	Bio         string       `bson:"bio,omitempty"`
+	GameHandles []GameHandle `bson:"game_handles,omitempty"`
+}
+
+// Type `ProfileUpdateRequest` represents the request body for changing the profile of the current user
+//
+// Omitted fields are left unchanged while fields given as empty values are cleared.
+//
+// Fields:
+//   - DisplayName: the name shown for the user
+//   - AvatarURL: the location of the picture shown for the user
+//   - Bio: a short description of the user
+//   - GameHandles: the names the user goes by within games (replaces every existing handle)
+type ProfileUpdateRequest struct {
+	DisplayName *string       `json:"displayName" binding:"omitempty,eq=|min=2,max=32"`
+	AvatarURL   *string       `json:"avatarUrl" binding:"omitempty,eq=|http_url,max=512"`
+	Bio         *string       `json:"bio" binding:"omitempty,max=280"`
+	GameHandles *[]GameHandle `json:"gameHandles" binding:"omitempty,max=10,dive"`
+}
+
+// Type `PublicProfile` represents the response body describing a user to any other user
+//
+// Fields:
+//   - ID: the user account ID
+//   - DisplayName: the name shown for the user
+//   - AvatarURL: the location of the picture shown for the user
+//   - Bio: a short description of the user
+//   - GameHandles: the names the user goes by within games
+//   - MemberSince: the timestamp of account creation
+type PublicProfile struct {
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	AvatarURL   string       `json:"avatarUrl,omitempty"`
+	Bio         string       `json:"bio,omitempty"`
+	GameHandles []GameHandle `json:"gameHandles,omitempty"`
+	MemberSince time.Time    `json:"memberSince"`
+}
+
+// Type `OwnProfile` represents the response body describing the current user to themselves
+//
+// Fields:
+//   - PublicProfile: the profile as shown to other users
+//   - Email: the login email of the account
+//   - EmailVerified: indicates the user proved ownership of the login email
+//   - MFAEnabled: indicates two-factor authentication is required to log in
+//   - LinkedProviders: the external identity providers the user can log in with
+type OwnProfile struct {
+	PublicProfile
+	Email           string   `json:"email"`
+	EmailVerified   bool     `json:"emailVerified"`
+	MFAEnabled      bool     `json:"mfaEnabled"`
+	LinkedProviders []string `json:"linkedProviders,omitempty"`
+}
@@ -0,0 +1,59 @@
+package models
+
+/*
+ * File: pkg/models/verification.go
+ *
+ * Purpose: data models for email verification
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"time"
+
+	"github.com/tournabyte/webapi/pkg/dbx"
+	"github.com/tournabyte/webapi/pkg/notify"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Variables storing query context associated with email verification operations
+var (
+	EmailVerificationQueryContext = dbx.NewQueryContext(`tournabyte`, `email_verifications`)
+)
+
+// Type `EmailVerificationRequest` represents the request body for confirming ownership of a login email
+//
+// Fields:
+//   - Token: the verification token delivered to the login email
+type EmailVerificationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// Type `EmailVerificationToken` represents a stored email verification token
+//
+// Fields:
+//   - ID: the hash of the token delivered to the user (the token itself is never stored)
+//   - Authorizes: the user ID whose login email the token verifies
+//   - Email: the login email the token was delivered to (the token is void if the login email changes)
+//   - NotValidAfter: the timestamp the token expires (the record is removed afterwards)
+//   - CreatedAt: the timestamp the token was issued
+type EmailVerificationToken struct {
+	ID            string        `bson:"_id"`
+	Authorizes    bson.ObjectID `bson:"authorizes"`
+	Email         string        `bson:"email"`
+	NotValidAfter time.Time     `bson:"not_valid_after"`
+	CreatedAt     time.Time     `bson:"created_at"`
+}
+
+// Type `EmailVerificationOptions` groups the information needed to issue and deliver email verification tokens
+//
+// Fields:
+//   - ExpiresIn: duration created verification tokens should remain valid
+//   - Notifier: the delivery channel of verification tokens
+type EmailVerificationOptions struct {
+	ExpiresIn time.Duration
+	Notifier  notify.Notifier
+}
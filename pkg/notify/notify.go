@@ -0,0 +1,201 @@
+package notify
+
+/*
+ * File: pkg/notify/notify.go
+ *
+ * Purpose: delivery of account notifications (password resets, verification links, ...) to users
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Errors specific to notification delivery
+var (
+	ErrMissingRecipient = errors.New("notification has no recipient")
+	ErrInvalidHeader    = errors.New("notification recipient and subject must not contain line breaks")
+)
+
+// Type `Message` represents a notification addressed to a single user
+//
+// Fields:
+//   - Recipient: the address the notification is delivered to
+//   - Subject: the short summary of the notification
+//   - Body: the plaintext content of the notification
+type Message struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Type `Notifier` represents a channel capable of delivering notifications to users
+type Notifier interface {
+	// Notify delivers the given message to its recipient
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Type `NotifierFunc` adapts an ordinary function to the `Notifier` interface
+type NotifierFunc func(ctx context.Context, msg Message) error
+
+// Function `(NotifierFunc).Notify` implements `Notifier.Notify` by calling the function itself
+func (f NotifierFunc) Notify(ctx context.Context, msg Message) error {
+	return f(ctx, msg)
+}
+
+// Type `logNotifier` implements `Notifier` by writing notifications to a logger (intended for development deployments)
+//
+// Members:
+//   - logger: the destination of the notifications
+type logNotifier struct {
+	logger *log.Logger
+}
+
+// Function `NewLogNotifier` creates a notifier writing notifications to the given logger
+//
+// Parameters:
+//   - logger: the destination of the notifications (the standard logger when nil)
+//
+// Returns:
+//   - `Notifier`: the notifier
+func NewLogNotifier(logger *log.Logger) Notifier {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &logNotifier{logger: logger}
+}
+
+// Function `(*logNotifier).Notify` implements `Notifier.Notify`
+func (n *logNotifier) Notify(ctx context.Context, msg Message) error {
+	if err := checkMessage(msg); err != nil {
+		return err
+	}
+
+	n.logger.Printf("[NOTIFY]: to=%q subject=%q\n%s", msg.Recipient, msg.Subject, msg.Body)
+	return nil
+}
+
+// Type `fileNotifier` implements `Notifier` by appending notifications to a file (intended for development deployments)
+//
+// Members:
+//   - path: the file notifications are appended to
+//   - mu: serializes writes to the file
+type fileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// Function `NewFileNotifier` creates a notifier appending notifications to the file at the given path
+//
+// Parameters:
+//   - path: the file notifications are appended to (created if missing)
+//
+// Returns:
+//   - `Notifier`: the notifier
+func NewFileNotifier(path string) Notifier {
+	return &fileNotifier{path: path}
+}
+
+// Function `(*fileNotifier).Notify` implements `Notifier.Notify`
+func (n *fileNotifier) Notify(ctx context.Context, msg Message) error {
+	if err := checkMessage(msg); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(f, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n", time.Now().UTC().Format(time.RFC1123Z), msg.Recipient, msg.Subject, msg.Body)
+	return errors.Join(err, f.Close())
+}
+
+// Type `smtpNotifier` implements `Notifier` by sending notifications as plaintext emails
+//
+// Members:
+//   - addr: the host:port of the mail server
+//   - sender: the address notifications are sent from
+//   - auth: the credentials presented to the mail server (nil to send unauthenticated)
+type smtpNotifier struct {
+	addr   string
+	sender string
+	auth   smtp.Auth
+}
+
+// Function `NewSMTPNotifier` creates a notifier sending notifications as emails through the given mail server
+//
+// Parameters:
+//   - host: the hostname of the mail server
+//   - port: the port of the mail server
+//   - sender: the address notifications are sent from
+//   - username: the user to authenticate to the mail server as (empty to send unauthenticated)
+//   - password: the password to authenticate to the mail server with
+//
+// Returns:
+//   - `Notifier`: the notifier
+func NewSMTPNotifier(host string, port uint, sender string, username string, password string) Notifier {
+	n := &smtpNotifier{
+		addr:   net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10)),
+		sender: sender,
+	}
+	if username != "" {
+		n.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return n
+}
+
+// Function `(*smtpNotifier).Notify` implements `Notifier.Notify`
+func (n *smtpNotifier) Notify(ctx context.Context, msg Message) error {
+	if err := checkMessage(msg); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var content strings.Builder
+	fmt.Fprintf(&content, "From: %s\r\n", n.sender)
+	fmt.Fprintf(&content, "To: %s\r\n", msg.Recipient)
+	fmt.Fprintf(&content, "Subject: %s\r\n", msg.Subject)
+	content.WriteString("MIME-Version: 1.0\r\n")
+	content.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	content.WriteString("\r\n")
+	content.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return smtp.SendMail(n.addr, n.auth, n.sender, []string{msg.Recipient}, []byte(content.String()))
+}
+
+// Function `checkMessage` ensures the given message can be delivered safely
+//
+// Parameters:
+//   - msg: the message to check
+//
+// Returns:
+//   - `error`: the reason the message cannot be delivered (nil if it can)
+func checkMessage(msg Message) error {
+	if msg.Recipient == "" {
+		return ErrMissingRecipient
+	}
+	if strings.ContainsAny(msg.Recipient, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return ErrInvalidHeader
+	}
+	return nil
+}
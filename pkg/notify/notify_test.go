@@ -0,0 +1,83 @@
+package notify
+
+/*
+ * File: pkg/notify/notify_test.go
+ *
+ * Purpose: unit tests for notification delivery
+ *
+ * License:
+ *  See LICENSE.md for full license
+ *  Copyright 2026 Part of the Tournabyte project
+ *
+ */
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogNotifier(t *testing.T) {
+	t.Run("MessageWritten", func(t *testing.T) {
+		var buf bytes.Buffer
+		n := NewLogNotifier(log.New(&buf, "", 0))
+
+		require.NoError(t, n.Notify(context.Background(), Message{Recipient: "user@example.com", Subject: "Hello", Body: "World"}))
+		assert.Contains(t, buf.String(), "user@example.com")
+		assert.Contains(t, buf.String(), "Hello")
+		assert.Contains(t, buf.String(), "World")
+	})
+
+	t.Run("MissingRecipientRejected", func(t *testing.T) {
+		n := NewLogNotifier(nil)
+		assert.ErrorIs(t, n.Notify(context.Background(), Message{Subject: "Hello"}), ErrMissingRecipient)
+	})
+}
+
+func TestFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.txt")
+	n := NewFileNotifier(path)
+
+	require.NoError(t, n.Notify(context.Background(), Message{Recipient: "first@example.com", Subject: "One", Body: "First"}))
+	require.NoError(t, n.Notify(context.Background(), Message{Recipient: "second@example.com", Subject: "Two", Body: "Second"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "To: first@example.com")
+	assert.Contains(t, string(data), "To: second@example.com")
+	assert.Contains(t, string(data), "Second")
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	t.Run("HeaderInjectionRejected", func(t *testing.T) {
+		n := NewSMTPNotifier("localhost", 25, "noreply@example.com", "", "")
+		err := n.Notify(context.Background(), Message{Recipient: "user@example.com", Subject: "Hello\r\nBcc: other@example.com"})
+		assert.ErrorIs(t, err, ErrInvalidHeader)
+	})
+
+	t.Run("CancelledContextNotSent", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		n := NewSMTPNotifier("localhost", 25, "noreply@example.com", "", "")
+		assert.ErrorIs(t, n.Notify(ctx, Message{Recipient: "user@example.com", Subject: "Hello"}), context.Canceled)
+	})
+}
+
+func TestNotifierFunc(t *testing.T) {
+	var got Message
+	n := NotifierFunc(func(ctx context.Context, msg Message) error {
+		got = msg
+		return nil
+	})
+
+	want := Message{Recipient: "user@example.com", Subject: "Hello", Body: "World"}
+	require.NoError(t, n.Notify(context.Background(), want))
+	assert.Equal(t, want, got)
+}